@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"fmt"
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
 	"github.com/irisnet/irishub-sdk-go/modules/gov"
 	"github.com/irisnet/irishub-sdk-go/modules/htlc"
 	"github.com/irisnet/irishub-sdk-go/modules/nft"
@@ -10,37 +12,45 @@ import (
 	"github.com/irisnet/irishub-sdk-go/modules/record"
 	"github.com/irisnet/irishub-sdk-go/modules/staking"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/legacy"
 	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
 	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
 	"github.com/irisnet/irishub-sdk-go/modules"
 	"github.com/irisnet/irishub-sdk-go/modules/bank"
 	"github.com/irisnet/irishub-sdk-go/modules/keys"
 	"github.com/irisnet/irishub-sdk-go/modules/service"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
 	"github.com/irisnet/irishub-sdk-go/modules/token"
 	"github.com/irisnet/irishub-sdk-go/types"
 	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
 )
 
+const msgInterfaceName = "cosmos.v1beta1.Msg"
+
 type IRISHUBClient struct {
 	logger         log.Logger
 	moduleManager  map[string]types.Module
 	encodingConfig types.EncodingConfig
 
 	types.BaseClient
-	Key     keys.Client
-	Bank    bank.Client
-	Token   token.Client
-	Staking staking.Client
-	Gov     gov.Client
-	Service service.Client
-	Record  record.Client
-	Random  random.Client
-	NFT     nft.Client
-	Oracle  oracle.Client
-	HTLC    htlc.Client
+	Key          keys.Client
+	Bank         bank.Client
+	Token        token.Client
+	Staking      staking.Client
+	Distribution distribution.Client
+	Slashing     slashing.Client
+	Gov          gov.Client
+	Authz        authz.Client
+	Service      service.Client
+	Record       record.Client
+	Random       random.Client
+	NFT          nft.Client
+	Oracle       oracle.Client
+	HTLC         htlc.Client
 }
 
 func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
@@ -52,8 +62,11 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 
 	bankClient := bank.NewClient(baseClient, encodingConfig.Marshaler)
 	tokenClient := token.NewClient(baseClient, encodingConfig.Marshaler)
+	distributionClient := distribution.NewClient(baseClient, encodingConfig.Marshaler)
 	stakingClient := staking.NewClient(baseClient, encodingConfig.Marshaler)
+	slashingClient := slashing.NewClient(baseClient, encodingConfig.Marshaler)
 	govClient := gov.NewClient(baseClient, encodingConfig.Marshaler)
+	authzClient := authz.NewClient(baseClient, encodingConfig.Marshaler)
 
 	serviceClient := service.NewClient(baseClient, encodingConfig.Marshaler)
 	recordClient := record.NewClient(baseClient, encodingConfig.Marshaler)
@@ -71,7 +84,10 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		Bank:           bankClient,
 		Token:          tokenClient,
 		Staking:        stakingClient,
+		Distribution:   distributionClient,
+		Slashing:       slashingClient,
 		Gov:            govClient,
+		Authz:          authzClient,
 		Service:        serviceClient,
 		Record:         recordClient,
 		Random:         randomClient,
@@ -84,7 +100,10 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		bankClient,
 		tokenClient,
 		stakingClient,
+		distributionClient,
+		slashingClient,
 		govClient,
+		authzClient,
 		serviceClient,
 		recordClient,
 		nftClient,
@@ -118,7 +137,10 @@ func (client *IRISHUBClient) RegisterModule(ms ...types.Module) {
 			panic(fmt.Sprintf("%s has register", m.Name()))
 		}
 
-		// m.RegisterCodec(client.encodingConfig.Amino)
+		if am, ok := m.(types.AminoModule); ok {
+			am.RegisterLegacyAminoCodec(client.encodingConfig.Amino)
+			legacy.RegisterModule(m.Name(), am.RegisterLegacyAminoCodec)
+		}
 		m.RegisterInterfaceTypes(client.encodingConfig.InterfaceRegistry)
 		client.moduleManager[m.Name()] = m
 	}
@@ -128,6 +150,45 @@ func (client *IRISHUBClient) Module(name string) types.Module {
 	return client.moduleManager[name]
 }
 
+// SendMsgs broadcasts arbitrary Msgs, including the ones of the custom modules added with RegisterModule.
+// Every Msg must be registered to the interface registry, otherwise the transaction could not be
+// decoded by QueryTx and SubscribeTx once committed.
+func (client *IRISHUBClient) SendMsgs(msgs []types.Msg, baseTx types.BaseTx) (types.ResultTx, types.Error) {
+	if len(msgs) == 0 {
+		return types.ResultTx{}, types.Wrapf("no msg to send")
+	}
+
+	registered := make(map[string]bool)
+	for _, typeURL := range client.encodingConfig.InterfaceRegistry.ListImplementations(msgInterfaceName) {
+		registered[typeURL] = true
+	}
+
+	for _, msg := range msgs {
+		typeURL := "/" + proto.MessageName(msg)
+		if !registered[typeURL] {
+			return types.ResultTx{}, types.Wrapf("msg %s is not registered, see RegisterModule", typeURL)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return types.ResultTx{}, types.Wrap(err)
+		}
+	}
+	return client.BuildAndSend(msgs, baseTx)
+}
+
+// MarshalProtoJSON renders a proto message, e.g. a Msg of a registered module, to JSON
+func (client *IRISHUBClient) MarshalProtoJSON(o proto.Message) ([]byte, error) {
+	return client.encodingConfig.Marshaler.MarshalJSON(o)
+}
+
+// MarshalTxJSON renders a transaction returned by QueryTx, QueryTxs or SubscribeTx to JSON,
+// the Msgs of the registered modules are rendered with their fields
+func (client *IRISHUBClient) MarshalTxJSON(tx types.Tx) ([]byte, error) {
+	if dtx, ok := tx.(*types.DynamicTx); ok {
+		return dtx.MarshalJSON()
+	}
+	return client.encodingConfig.TxConfig.TxJSONEncoder()(tx)
+}
+
 func makeEncodingConfig() types.EncodingConfig {
 	amino := codec.NewLegacyAmino()
 	interfaceRegistry := cdctypes.NewInterfaceRegistry()
@@ -154,7 +215,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // RegisterInterfaces registers the sdk message type.
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
-	registry.RegisterInterface("cosmos.v1beta1.Msg", (*types.Msg)(nil))
+	registry.RegisterInterface(msgInterfaceName, (*types.Msg)(nil))
 	txtypes.RegisterInterfaces(registry)
 	cryptocodec.RegisterInterfaces(registry)
 }
@@ -30,6 +30,7 @@ type (
 		keyManager         sdk.KeyManager
 		txConfig           sdk.TxConfig
 		queryFunc          QueryWithData
+		metrics            sdk.Metrics
 	}
 
 	// QueryWithData implements a query method from cschain.
@@ -38,7 +39,7 @@ type (
 
 // NewFactory return a point of the instance of Factory.
 func NewFactory() *Factory {
-	return &Factory{}
+	return &Factory{metrics: sdk.NopMetrics()}
 }
 
 // ChainID returns the chainID of the current chain.
@@ -168,15 +169,23 @@ func (f *Factory) WithQueryFunc(queryFunc QueryWithData) *Factory {
 	return f
 }
 
+// WithMetrics returns a pointer of the context with a metrics collector.
+func (f *Factory) WithMetrics(metrics sdk.Metrics) *Factory {
+	f.metrics = metrics
+	return f
+}
+
 func (f *Factory) BuildAndSign(name string, msgs []sdk.Msg) ([]byte, error) {
 	tx, err := f.BuildUnsignedTx(msgs)
 	if err != nil {
 		return nil, err
 	}
+	f.metrics.TxBuilt()
 
 	if err = f.Sign(name, tx); err != nil {
 		return nil, err
 	}
+	f.metrics.TxSigned()
 
 	txBytes, err := f.txConfig.TxEncoder()(tx.GetTx())
 	if err != nil {
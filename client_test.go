@@ -0,0 +1,210 @@
+package sdk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/legacy"
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const customSender = "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z"
+
+// customFileDescriptor is the gzipped descriptor of custom/v1/tx.proto declaring MsgCustom
+var customFileDescriptor = func() []byte {
+	field := func(name string, number int32) *descriptor.FieldDescriptorProto {
+		return &descriptor.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}
+	}
+	bz, err := proto.Marshal(&descriptor.FileDescriptorProto{
+		Name:    proto.String("custom/v1/tx.proto"),
+		Package: proto.String("custom.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name:  proto.String("MsgCustom"),
+			Field: []*descriptor.FieldDescriptorProto{field("sender", 1), field("data", 2)},
+		}},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write(bz)
+	_ = w.Close()
+	return buf.Bytes()
+}()
+
+func init() {
+	proto.RegisterFile("custom/v1/tx.proto", customFileDescriptor)
+	proto.RegisterType((*MsgCustom)(nil), "custom.v1.MsgCustom")
+}
+
+// MsgCustom is the Msg of a module of another chain, unknown to the sdk.
+// It is marshaled by hand, the way protoc-gen-gogo would generate it.
+type MsgCustom struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgCustom) Reset()         { *m = MsgCustom{} }
+func (m *MsgCustom) String() string { return proto.CompactTextString(m) }
+func (*MsgCustom) ProtoMessage()    {}
+func (*MsgCustom) Descriptor() ([]byte, []int) {
+	return customFileDescriptor, []int{0}
+}
+
+func (m MsgCustom) Route() string { return "custom" }
+func (m MsgCustom) Type() string  { return "custom" }
+
+func (m MsgCustom) ValidateBasic() error {
+	if len(m.Sender) == 0 {
+		return errors.New("missing sender")
+	}
+	return nil
+}
+
+func (m MsgCustom) GetSignBytes() []byte {
+	return types.MustSortJSON(legacy.Cdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCustom) GetSigners() []types.AccAddress {
+	return []types.AccAddress{types.MustAccAddressFromBech32(m.Sender)}
+}
+
+func (m *MsgCustom) Marshal() ([]byte, error) {
+	var bz []byte
+	for i, field := range []string{m.Sender, m.Data} {
+		if len(field) > 0 {
+			bz = append(bz, proto.EncodeVarint(uint64(i+1)<<3|proto.WireBytes)...)
+			bz = append(bz, proto.EncodeVarint(uint64(len(field)))...)
+			bz = append(bz, field...)
+		}
+	}
+	return bz, nil
+}
+
+func (m *MsgCustom) MarshalTo(dAtA []byte) (int, error) {
+	bz, _ := m.Marshal()
+	return copy(dAtA, bz), nil
+}
+
+func (m *MsgCustom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	bz, _ := m.Marshal()
+	return copy(dAtA[len(dAtA)-len(bz):], bz), nil
+}
+
+func (m *MsgCustom) Size() int {
+	bz, _ := m.Marshal()
+	return len(bz)
+}
+
+func (m *MsgCustom) Unmarshal(dAtA []byte) error {
+	m.Reset()
+	for len(dAtA) > 0 {
+		key, n := proto.DecodeVarint(dAtA)
+		if n == 0 || key&7 != proto.WireBytes {
+			return io.ErrUnexpectedEOF
+		}
+		dAtA = dAtA[n:]
+
+		length, n := proto.DecodeVarint(dAtA)
+		if n == 0 || uint64(len(dAtA)-n) < length {
+			return io.ErrUnexpectedEOF
+		}
+		field := string(dAtA[n : n+int(length)])
+		dAtA = dAtA[n+int(length):]
+
+		switch key >> 3 {
+		case 1:
+			m.Sender = field
+		case 2:
+			m.Data = field
+		}
+	}
+	return nil
+}
+
+// customModule is the client module of MsgCustom
+type customModule struct{}
+
+func (customModule) Name() string {
+	return "custom"
+}
+
+func (customModule) RegisterInterfaceTypes(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*types.Msg)(nil), &MsgCustom{})
+}
+
+func (customModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCustom{}, "custom/MsgCustom", nil)
+}
+
+func newTestClient(t *testing.T) IRISHUBClient {
+	cfg, err := types.NewClientConfig("tcp://127.0.0.1:26657", "127.0.0.1:9090", "test",
+		types.KeyDAOOption(store.NewMemory(nil)),
+	)
+	require.NoError(t, err)
+	return NewIRISHUBClient(cfg)
+}
+
+func TestRegisterModule(t *testing.T) {
+	client := newTestClient(t)
+	msg := &MsgCustom{Sender: customSender, Data: "data"}
+	baseTx := types.BaseTx{From: "test", Password: "password"}
+
+	_, sendErr := client.SendMsgs([]types.Msg{msg}, baseTx)
+	require.Error(t, sendErr)
+	require.Contains(t, sendErr.Error(), "not registered")
+
+	client.RegisterModule(customModule{})
+	require.Panics(t, func() { client.RegisterModule(customModule{}) })
+	// the amino types are registered once to the global codec, whatever the number of clients
+	other := newTestClient(t)
+	other.RegisterModule(customModule{})
+
+	// the sign bytes of the amino json sign mode
+	require.Equal(t,
+		`{"type":"custom/MsgCustom","value":{"data":"data","sender":"`+customSender+`"}}`,
+		string(msg.GetSignBytes()),
+	)
+	bz, err := client.Codec().MarshalJSON(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"type":"custom/MsgCustom"`)
+
+	// a transaction of the msg is decoded, e.g. by QueryTx, and rendered to json
+	txConfig := client.encodingConfig.TxConfig
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, []types.Msg{msg}, tx.GetMsgs())
+
+	bz, err = client.MarshalTxJSON(tx)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `{"@type":"/custom.v1.MsgCustom","sender":"`+customSender+`","data":"data"}`)
+
+	// the registered msg is validated before it is signed
+	_, sendErr = client.SendMsgs([]types.Msg{&MsgCustom{Data: "data"}}, baseTx)
+	require.Error(t, sendErr)
+	require.Contains(t, sendErr.Error(), "missing sender")
+}
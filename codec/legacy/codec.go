@@ -1,19 +1,39 @@
 package legacy
 
 import (
+	"sync"
+
 	"github.com/irisnet/irishub-sdk-go/codec"
 	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
 )
 
-// Cdc defines a global generic sealed Amino codec to be used throughout sdk. It
-// has all Tendermint crypto and evidence types registered.
+// Cdc defines a global generic Amino codec to be used throughout sdk. It
+// has all Tendermint crypto and evidence types registered, and the amino
+// types of the modules registered with RegisterModule.
 //
 // TODO: Deprecated - remove this global.
 var Cdc *codec.LegacyAmino
 
+var (
+	mu      sync.Mutex
+	modules = make(map[string]bool)
+)
+
 func init() {
 	Cdc = codec.NewLegacyAmino()
 	cryptocodec.RegisterCrypto(Cdc)
 	codec.RegisterEvidences(Cdc)
-	Cdc.Seal()
+}
+
+// RegisterModule registers the amino types of the named module to Cdc with register.
+// A module is registered once, whatever the number of clients it is added to.
+func RegisterModule(name string, register func(cdc *codec.LegacyAmino)) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if modules[name] {
+		return
+	}
+	register(Cdc)
+	modules[name] = true
 }
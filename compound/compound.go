@@ -0,0 +1,337 @@
+// Package compound restakes the staking rewards of a delegator: every interval, the rewards are withdrawn
+// and delegated again across a set of validators according to their weights.
+//
+//	c, err := compound.New(client, client.Distribution, compound.Options{
+//		BaseTx:     sdk.BaseTx{From: "custody", Password: "password", Fee: fee, Mode: sdk.Commit},
+//		Targets:    []compound.Target{{Validator: "iva1...", Weight: sdk.NewDec(2)}, {Validator: "iva1...", Weight: sdk.NewDec(1)}},
+//		MinRewards: sdk.NewDecCoin("iris", sdk.NewInt(10)),
+//		FeeBudget:  budget,
+//		Store:      store,
+//	})
+//	err = c.Run(ctx)
+//
+// The withdrawals and the delegations of a run are broadcast in one tx, so that the delegations spend the
+// withdrawn rewards and a run costs BaseTx.Fee. A run is skipped when the rewards, less the fee, are below
+// MinRewards, or when the fee would exceed the fee budget. The fee of a failed run is spent as well when its
+// tx reached the node or its broadcast has an unknown outcome. The last run is persisted in the Store, so that
+// a restarted compounder keeps the interval and the fees spent.
+package compound
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const defaultInterval = 24 * time.Hour
+
+// Client is the client the txs are sent with, e.g. sdk.IRISHUBClient
+type Client interface {
+	QueryAddress(name, password string) (sdk.AccAddress, sdk.Error)
+	ToMinCoin(coin ...sdk.DecCoin) (sdk.Coins, sdk.Error)
+	BuildAndSendWithContext(ctx context.Context, msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+}
+
+// RewardsQuerier queries the rewards of the delegator, e.g. distribution.Client
+type RewardsQuerier interface {
+	QueryRewards(delegatorAddr string) (distribution.QueryRewardsResp, sdk.Error)
+}
+
+// Target is a validator the rewards are delegated to, in proportion of its weight among the targets
+type Target struct {
+	Validator string  `json:"validator"`
+	Weight    sdk.Dec `json:"weight"`
+}
+
+// Options are the options of a Compounder
+type Options struct {
+	// the delegator signing the txs, BaseTx.Fee is the fee of the tx of a run and is required with a FeeBudget
+	BaseTx  sdk.BaseTx
+	Targets []Target
+	// time between two runs, 24 hours by default
+	Interval time.Duration
+	// minimum amount of rewards delegated by a run, less the fee. Its denom is the compounded one
+	MinRewards sdk.DecCoin
+	// total amount of fees the compounder may spend, unlimited if empty
+	FeeBudget sdk.DecCoins
+	// plan the runs without sending the txs or saving the state
+	DryRun bool
+	// store of the state, kept in memory by default
+	Store StateStore
+	// called after every run, including the skipped ones
+	OnRun  func(Result)
+	Logger log.Logger
+}
+
+// Delegation is a delegation of a run
+type Delegation struct {
+	Validator string   `json:"validator"`
+	Amount    sdk.Coin `json:"amount"`
+}
+
+// Result is the outcome of a run
+type Result struct {
+	Time      time.Time `json:"time"`
+	Delegator string    `json:"delegator"`
+	// rewards of the compounded denom
+	Rewards sdk.Coin `json:"rewards"`
+	// validators the rewards are withdrawn from
+	Withdrawals []string     `json:"withdrawals"`
+	Delegations []Delegation `json:"delegations"`
+	Fee         sdk.Coins    `json:"fee"`
+	DryRun      bool         `json:"dry_run"`
+	// reason why the run is skipped, empty if the txs are sent
+	Skipped string   `json:"skipped,omitempty"`
+	Hashes  []string `json:"hashes,omitempty"`
+}
+
+// Compounder withdraws and delegates the rewards of a delegator periodically
+type Compounder struct {
+	client  Client
+	rewards RewardsQuerier
+	opts    Options
+
+	delegator   sdk.AccAddress
+	minRewards  sdk.Coin
+	feePerTx    sdk.Coins
+	feeBudget   sdk.Coins
+	totalWeight sdk.Dec
+}
+
+// New returns a Compounder of the delegator BaseTx.From
+func New(client Client, rewards RewardsQuerier, opts Options) (*Compounder, error) {
+	if len(opts.Targets) == 0 {
+		return nil, errors.New("no target validator")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, target := range opts.Targets {
+		if _, err := sdk.ValAddressFromBech32(target.Validator); err != nil {
+			return nil, err
+		}
+		if target.Weight.IsNil() || !target.Weight.IsPositive() {
+			return nil, sdk.Wrapf("weight of the validator %s is not positive", target.Validator)
+		}
+		totalWeight = totalWeight.Add(target.Weight)
+	}
+
+	if len(opts.MinRewards.Denom) == 0 {
+		return nil, errors.New("MinRewards is required, its denom is the compounded one")
+	}
+	if len(opts.FeeBudget) > 0 && len(opts.BaseTx.Fee) == 0 {
+		return nil, errors.New("BaseTx.Fee is required with a fee budget")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultInterval
+	}
+	if opts.Store == nil {
+		opts.Store = NewMemoryStore()
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+
+	delegator, err := client.QueryAddress(opts.BaseTx.From, opts.BaseTx.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	minRewards, err := client.ToMinCoin(opts.MinRewards)
+	if err != nil {
+		return nil, err
+	}
+
+	feePerTx, err := client.ToMinCoin(opts.BaseTx.Fee...)
+	if err != nil {
+		return nil, err
+	}
+
+	feeBudget, err := client.ToMinCoin(opts.FeeBudget...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Compounder{
+		client:      client,
+		rewards:     rewards,
+		opts:        opts,
+		delegator:   delegator,
+		minRewards:  minRewards[0],
+		feePerTx:    feePerTx,
+		feeBudget:   feeBudget,
+		totalWeight: totalWeight,
+	}, nil
+}
+
+// State returns the persisted state of the compounder
+func (c *Compounder) State() (State, error) {
+	return c.opts.Store.Load()
+}
+
+// Run runs the compounder every interval since its last run, it returns when ctx is done.
+// A failed run is logged and retried after the interval
+func (c *Compounder) Run(ctx context.Context) error {
+	state, err := c.opts.Store.Load()
+	if err != nil {
+		return err
+	}
+
+	last := state.LastRun
+	for {
+		if wait := time.Until(last.Add(c.opts.Interval)); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		last = time.Now()
+		if _, err := c.RunOnce(ctx); err != nil {
+			c.opts.Logger.Error("compound rewards failed", "delegator", c.delegator.String(), "errMsg", err.Error())
+		}
+	}
+}
+
+// RunOnce withdraws and delegates the rewards now, unless the run is skipped
+func (c *Compounder) RunOnce(ctx context.Context) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	state, err := c.opts.Store.Load()
+	if err != nil {
+		return Result{}, err
+	}
+
+	res, msgs, err := c.plan(state)
+	if err != nil {
+		return Result{}, err
+	}
+	if res.Skipped != "" || c.opts.DryRun {
+		c.notify(res)
+		return res, nil
+	}
+
+	result, txErr := c.client.BuildAndSendWithContext(ctx, msgs, c.opts.BaseTx)
+	if txErr != nil {
+		if !feeMayBeSpent(txErr) {
+			res.Fee = sdk.NewCoins()
+			return res, txErr
+		}
+		if len(txErr.TxHash()) > 0 {
+			res.Hashes = []string{txErr.TxHash()}
+		}
+		state.FeeSpent = state.FeeSpent.Add(res.Fee...)
+		if e := c.opts.Store.Save(state); e != nil {
+			c.opts.Logger.Error("save compound state failed", "errMsg", e.Error())
+		}
+		return res, txErr
+	}
+
+	res.Hashes = []string{result.Hash}
+	state.FeeSpent = state.FeeSpent.Add(res.Fee...)
+
+	state.LastRun = res.Time
+	state.Runs++
+	state.LastHashes = res.Hashes
+	for _, d := range res.Delegations {
+		state.Compounded = state.Compounded.Add(d.Amount)
+	}
+	if err := c.opts.Store.Save(state); err != nil {
+		return res, err
+	}
+
+	c.notify(res)
+	return res, nil
+}
+
+// feeMayBeSpent tells if the fee of a tx failed with err may be charged: the tx reached the node, e.g. it
+// failed in a block, or the outcome of its broadcast is unknown, e.g. the node timed out or was unavailable
+func feeMayBeSpent(err sdk.Error) bool {
+	if len(err.TxHash()) > 0 || sdk.IsRetryable(err) || errors.Is(err, context.Canceled) {
+		return true
+	}
+	var rpcErr *rpctypes.RPCError
+	return errors.As(err, &rpcErr)
+}
+
+// plan computes the withdrawals and the delegations of a run
+func (c *Compounder) plan(state State) (Result, sdk.Msgs, error) {
+	denom := c.minRewards.Denom
+	res := Result{
+		Time:      time.Now(),
+		Delegator: c.delegator.String(),
+		Rewards:   sdk.NewCoin(denom, sdk.ZeroInt()),
+		Fee:       c.feePerTx,
+		DryRun:    c.opts.DryRun,
+	}
+
+	rewards, err := c.rewards.QueryRewards(c.delegator.String())
+	if err != nil {
+		return res, nil, err
+	}
+
+	var msgs sdk.Msgs
+	for _, r := range rewards.Rewards {
+		amount := r.Reward.AmountOf(denom).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+		res.Rewards = res.Rewards.Add(sdk.NewCoin(denom, amount))
+		res.Withdrawals = append(res.Withdrawals, r.ValidatorAddress)
+		msgs = append(msgs, &distribution.MsgWithdrawDelegatorReward{
+			DelegatorAddress: c.delegator.String(),
+			ValidatorAddress: r.ValidatorAddress,
+		})
+	}
+
+	if len(c.feeBudget) > 0 && !state.FeeSpent.Add(c.feePerTx...).IsAllLTE(c.feeBudget) {
+		res.Skipped = "fee budget exhausted"
+		return res, nil, nil
+	}
+
+	// the fee is kept from the rewards, so that compounding does not drain the balance
+	available := res.Rewards.Amount.Sub(c.feePerTx.AmountOf(denom))
+	if available.LT(c.minRewards.Amount) || !available.IsPositive() {
+		res.Skipped = "rewards below the minimum"
+		return res, nil, nil
+	}
+
+	delegated := sdk.ZeroInt()
+	for i, target := range c.opts.Targets {
+		amount := target.Weight.MulInt(available).Quo(c.totalWeight).TruncateInt()
+		if i == len(c.opts.Targets)-1 {
+			// the last target gets the remainder of the truncations
+			amount = available.Sub(delegated)
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		delegated = delegated.Add(amount)
+
+		delegation := Delegation{Validator: target.Validator, Amount: sdk.NewCoin(denom, amount)}
+		res.Delegations = append(res.Delegations, delegation)
+		msgs = append(msgs, &staking.MsgDelegate{
+			DelegatorAddress: c.delegator.String(),
+			ValidatorAddress: delegation.Validator,
+			Amount:           delegation.Amount,
+		})
+	}
+	return res, msgs, nil
+}
+
+func (c *Compounder) notify(res Result) {
+	if c.opts.OnRun != nil {
+		c.opts.OnRun(res)
+	}
+}
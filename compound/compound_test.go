@@ -0,0 +1,205 @@
+package compound
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// client converts "iris" to "uiris" and records the msgs sent, the txs fail with err if set
+type client struct {
+	delegator sdk.AccAddress
+	rewards   distribution.QueryRewardsResp
+	sent      [][]sdk.Msg
+	err       sdk.Error
+}
+
+func (c *client) QueryAddress(name, password string) (sdk.AccAddress, sdk.Error) {
+	return c.delegator, nil
+}
+
+func (c *client) ToMinCoin(coins ...sdk.DecCoin) (sdk.Coins, sdk.Error) {
+	var res sdk.Coins
+	for _, coin := range coins {
+		res = append(res, sdk.NewCoin("u"+coin.Denom, coin.Amount.MulInt64(1000000).TruncateInt()))
+	}
+	return res.Sort(), nil
+}
+
+func (c *client) BuildAndSendWithContext(ctx context.Context, msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	if err := ctx.Err(); err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+	c.sent = append(c.sent, msgs)
+	if c.err != nil {
+		return sdk.ResultTx{}, c.err
+	}
+	return sdk.ResultTx{Hash: strings.Repeat("A", len(c.sent))}, nil
+}
+
+func (c *client) QueryRewards(delegatorAddr string) (distribution.QueryRewardsResp, sdk.Error) {
+	return c.rewards, nil
+}
+
+func TestCompounder(t *testing.T) {
+	validator1 := sdk.ValAddress("validator1__________").String()
+	validator2 := sdk.ValAddress("validator2__________").String()
+	reward := func(validator string, amount int64) distribution.DelegationRewardResp {
+		return distribution.DelegationRewardResp{
+			ValidatorAddress: validator,
+			Reward:           sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(amount*10+5, 1))),
+		}
+	}
+
+	c := &client{
+		delegator: sdk.AccAddress("delegator___________"),
+		rewards: distribution.QueryRewardsResp{Rewards: []distribution.DelegationRewardResp{
+			reward(validator1, 2500000),
+			reward(validator2, 600000),
+		}},
+	}
+
+	store := NewFileStore(filepath.Join(t.TempDir(), "compound.json"))
+	var results []Result
+	opts := Options{
+		BaseTx: sdk.BaseTx{From: "delegator", Fee: sdk.NewDecCoins(sdk.NewDecCoinFromDec("iris", sdk.NewDecWithPrec(1, 1)))},
+		Targets: []Target{
+			{Validator: validator1, Weight: sdk.NewDec(2)},
+			{Validator: validator2, Weight: sdk.NewDec(1)},
+		},
+		MinRewards: sdk.NewDecCoin("iris", sdk.NewInt(1)),
+		FeeBudget:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("iris", sdk.NewDecWithPrec(15, 2))),
+		Store:      store,
+		OnRun:      func(res Result) { results = append(results, res) },
+		DryRun:     true,
+	}
+
+	// the dry run plans without sending
+	dry, err := New(c, c, opts)
+	require.NoError(t, err)
+	res, err := dry.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, c.sent)
+	require.Equal(t, sdk.NewCoin("uiris", sdk.NewInt(3100000)), res.Rewards)
+	require.Equal(t, []string{validator1, validator2}, res.Withdrawals)
+
+	// 3.1iris less the fee of 0.1iris is split 2:1
+	require.Equal(t, []Delegation{
+		{Validator: validator1, Amount: sdk.NewCoin("uiris", sdk.NewInt(2000000))},
+		{Validator: validator2, Amount: sdk.NewCoin("uiris", sdk.NewInt(1000000))},
+	}, res.Delegations)
+
+	state, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, State{}, state)
+
+	opts.DryRun = false
+	compounder, err := New(c, c, opts)
+	require.NoError(t, err)
+
+	// a run whose context is done sends nothing and spends no fee
+	canceled, cancelRun := context.WithCancel(context.Background())
+	cancelRun()
+	_, err = compounder.RunOnce(canceled)
+	require.Error(t, err)
+	require.Empty(t, c.sent)
+	state, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, State{}, state)
+
+	res, err = compounder.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, res.Skipped)
+	require.Len(t, c.sent, 1)
+	require.Len(t, c.sent[0], 4)
+	require.IsType(t, &distribution.MsgWithdrawDelegatorReward{}, c.sent[0][0])
+	require.Equal(t, validator2, c.sent[0][3].(*staking.MsgDelegate).ValidatorAddress)
+
+	state, err = compounder.State()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), state.Runs)
+	require.Equal(t, []string{"A"}, state.LastHashes)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uiris", sdk.NewInt(100000))), state.FeeSpent)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uiris", sdk.NewInt(3000000))), state.Compounded)
+
+	// the fee of another run exceeds the budget of 0.15iris
+	res, err = compounder.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "fee budget exhausted", res.Skipped)
+	require.Len(t, c.sent, 1)
+
+	opts.FeeBudget = nil
+	opts.MinRewards = sdk.NewDecCoin("iris", sdk.NewInt(5))
+	compounder, err = New(c, c, opts)
+	require.NoError(t, err)
+	res, err = compounder.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "rewards below the minimum", res.Skipped)
+	require.Len(t, results, 4)
+
+	// the state survives a restart, the next run is an interval after the last one
+	opts.Interval = time.Hour
+	compounder, err = New(c, c, opts)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, compounder.Run(ctx), context.DeadlineExceeded)
+	require.Len(t, results, 4)
+}
+
+func TestCompounderFailedTx(t *testing.T) {
+	validator := sdk.ValAddress("validator1__________").String()
+	c := &client{
+		delegator: sdk.AccAddress("delegator___________"),
+		rewards: distribution.QueryRewardsResp{Rewards: []distribution.DelegationRewardResp{{
+			ValidatorAddress: validator,
+			Reward:           sdk.NewDecCoins(sdk.NewDecCoin("uiris", sdk.NewInt(3000000))),
+		}}},
+	}
+
+	compounder, err := New(c, c, Options{
+		BaseTx:     sdk.BaseTx{From: "delegator", Fee: sdk.NewDecCoins(sdk.NewDecCoinFromDec("iris", sdk.NewDecWithPrec(1, 1)))},
+		Targets:    []Target{{Validator: validator, Weight: sdk.NewDec(1)}},
+		MinRewards: sdk.NewDecCoin("iris", sdk.NewInt(1)),
+		FeeBudget:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("iris", sdk.NewDecWithPrec(25, 2))),
+	})
+	require.NoError(t, err)
+	fee := sdk.NewCoins(sdk.NewCoin("uiris", sdk.NewInt(100000)))
+
+	// a tx rejected before reaching the node spends no fee
+	c.err = sdk.Wrapf("key not found")
+	res, err := compounder.RunOnce(context.Background())
+	require.Error(t, err)
+	require.True(t, res.Fee.IsZero())
+	state, err := compounder.State()
+	require.NoError(t, err)
+	require.True(t, state.FeeSpent.IsZero())
+
+	// the fee of a tx failed in a block is spent, although the run failed
+	c.err = sdk.GetTxError("ABCD", sdk.RootCodespace, uint32(sdk.OutOfGas), "out of gas")
+	res, err = compounder.RunOnce(context.Background())
+	require.Error(t, err)
+	require.Equal(t, []string{"ABCD"}, res.Hashes)
+	require.Equal(t, fee, res.Fee)
+	state, err = compounder.State()
+	require.NoError(t, err)
+	require.Equal(t, fee, state.FeeSpent)
+	require.Zero(t, state.Runs)
+
+	// so is the fee of a tx whose broadcast has an unknown outcome, the budget is exhausted then
+	c.err = sdk.Wrap(context.DeadlineExceeded)
+	_, err = compounder.RunOnce(context.Background())
+	require.Error(t, err)
+	res, err = compounder.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "fee budget exhausted", res.Skipped)
+	require.Len(t, c.sent, 3)
+}
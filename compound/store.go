@@ -0,0 +1,109 @@
+package compound
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// State is the persisted state of a Compounder
+type State struct {
+	// time of the last run which sent its txs
+	LastRun    time.Time `json:"last_run"`
+	Runs       uint64    `json:"runs"`
+	LastHashes []string  `json:"last_hashes,omitempty"`
+	// fees spent by all the runs, in min unit
+	FeeSpent sdk.Coins `json:"fee_spent"`
+	// rewards delegated by all the runs, in min unit
+	Compounded sdk.Coins `json:"compounded"`
+}
+
+// StateStore persists the state of a Compounder
+type StateStore interface {
+	// Load returns the saved state, the zero state if none is saved
+	Load() (State, error)
+	Save(state State) error
+}
+
+var (
+	_ StateStore = &MemoryStore{}
+	_ StateStore = FileStore{}
+)
+
+// MemoryStore is a StateStore kept in memory, it does not survive a restart
+type MemoryStore struct {
+	mu    sync.RWMutex
+	state State
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Load() (State, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.state, nil
+}
+
+func (s *MemoryStore) Save(state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	return nil
+}
+
+// FileStore is a StateStore saved as JSON to a file, the file is replaced atomically
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns a FileStore saving to the file at path
+func NewFileStore(path string) FileStore {
+	return FileStore{path: path}
+}
+
+func (s FileStore) Load() (state State, err error) {
+	bz, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal(bz, &state)
+	return state, err
+}
+
+func (s FileStore) Save(state State) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(bz); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
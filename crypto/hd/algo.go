@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
 )
 
 type SignatureAlgo interface {
@@ -19,6 +20,8 @@ func NewSigningAlgoFromString(str string) (SignatureAlgo, error) {
 	switch str {
 	case string(Secp256k1.Name()):
 		return Secp256k1, nil
+	case string(Sm2.Name()):
+		return Sm2, nil
 	default:
 		return nil, fmt.Errorf("provided algorithm `%s` is not supported", str)
 	}
@@ -37,11 +40,15 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Sm2Type represents the SM2 signature system of the Chinese national standard.
+	Sm2Type = PubKeyType("sm2")
 )
 
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Sm2 uses the SM2 ECDSA parameters.
+	Sm2 = sm2Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -62,18 +69,11 @@ func (s secp256k1Algo) Name() PubKeyType {
 // Derive derives and returns the secp256k1 private key for the given seed and HD path.
 func (s secp256k1Algo) Derive() DeriveFn {
 	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
-		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		keys, err := deriveKeys(mnemonic, bip39Passphrase, []string{hdPath})
 		if err != nil {
 			return nil, err
 		}
-
-		masterPriv, ch := ComputeMastersFromSeed(seed)
-		if len(hdPath) == 0 {
-			return masterPriv[:], nil
-		}
-		derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
-
-		return derivedKey, err
+		return keys[0], nil
 	}
 }
 
@@ -86,3 +86,91 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type sm2Algo struct {
+}
+
+func (s sm2Algo) Name() PubKeyType {
+	return Sm2Type
+}
+
+// Derive derives and returns the sm2 private key for the given seed and HD path.
+// The BIP32 derivation is the same as secp256k1, which is compatible with IRIS Hub,
+// a derived key which is not a valid sm2 scalar is rejected.
+func (s sm2Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		keys, err := deriveSm2Keys(mnemonic, bip39Passphrase, []string{hdPath})
+		if err != nil {
+			return nil, err
+		}
+		return keys[0], nil
+	}
+}
+
+// Generate generates a sm2 private key from the given bytes.
+func (s sm2Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		var bzArr = make([]byte, sm2.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &sm2.PrivKey{Key: bzArr}
+	}
+}
+
+// DeriveBatch derives the private keys of several HD paths from one mnemonic, the seed and the master key
+// are computed once for the whole batch
+func DeriveBatch(algo SignatureAlgo, mnemonic, bip39Passphrase string, hdPaths []string) ([][]byte, error) {
+	switch algo.Name() {
+	case Secp256k1Type:
+		return deriveKeys(mnemonic, bip39Passphrase, hdPaths)
+	case Sm2Type:
+		return deriveSm2Keys(mnemonic, bip39Passphrase, hdPaths)
+	default:
+		keys := make([][]byte, len(hdPaths))
+		for i, hdPath := range hdPaths {
+			key, err := algo.Derive()(mnemonic, bip39Passphrase, hdPath)
+			if err != nil {
+				return nil, err
+			}
+			keys[i] = key
+		}
+		return keys, nil
+	}
+}
+
+// deriveKeys derives the BIP32 private keys of the HD paths, an empty path is the master key
+func deriveKeys(mnemonic, bip39Passphrase string, hdPaths []string) ([][]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPriv, ch := ComputeMastersFromSeed(seed)
+	keys := make([][]byte, len(hdPaths))
+	for i, hdPath := range hdPaths {
+		if len(hdPath) == 0 {
+			keys[i] = masterPriv[:]
+			continue
+		}
+		if keys[i], err = DerivePrivateKeyForPath(masterPriv, ch, hdPath); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// deriveSm2Keys derives the BIP32 private keys of the HD paths and checks they are in [1, N-1], N being
+// the order of the sm2 curve which is lower than the one of secp256k1
+func deriveSm2Keys(mnemonic, bip39Passphrase string, hdPaths []string) ([][]byte, error) {
+	keys, err := deriveKeys(mnemonic, bip39Passphrase, hdPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		if !sm2.IsValidPrivKey(key) {
+			return nil, fmt.Errorf("the key derived at %s is not a valid sm2 private key", hdPaths[i])
+		}
+	}
+	return keys, nil
+}
@@ -50,12 +50,36 @@ func NewAlgoKeyManager(algo string) (KeyManager, error) {
 }
 
 func NewMnemonicKeyManager(mnemonic string, algo string) (KeyManager, error) {
-	k := keyManager{
-		mnemonic: mnemonic,
-		algo:     algo,
+	return NewMnemonicKeyManagerWithHDPath(mnemonic, algo, defaultBIP39Passphrase, hd.FullPath)
+}
+
+// NewMnemonicKeyManagerWithHDPath derives the key at the given BIP44 path (e.g. "44'/118'/0'/0/0"),
+// bip39Passphrase is the optional "25th word" used to generate the seed
+func NewMnemonicKeyManagerWithHDPath(mnemonic, algo, bip39Passphrase, hdPath string) (KeyManager, error) {
+	kms, err := NewMnemonicKeyManagers(mnemonic, algo, bip39Passphrase, []string{hdPath})
+	if err != nil {
+		return &keyManager{mnemonic: mnemonic, algo: algo}, err
 	}
-	err := k.recoveryFromMnemonic(mnemonic, hd.FullPath, algo)
-	return &k, err
+	return kms[0], nil
+}
+
+// NewMnemonicKeyManagers derives the keys at several BIP44 paths of one mnemonic,
+// the seed is generated once for all the paths
+func NewMnemonicKeyManagers(mnemonic, algo, bip39Passphrase string, hdPaths []string) ([]KeyManager, error) {
+	privKeys, err := recoveryFromMnemonic(mnemonic, bip39Passphrase, hdPaths, algo)
+	if err != nil {
+		return nil, err
+	}
+
+	kms := make([]KeyManager, len(privKeys))
+	for i, privKey := range privKeys {
+		kms[i] = &keyManager{
+			privKey:  privKey,
+			mnemonic: mnemonic,
+			algo:     algo,
+		}
+	}
+	return kms, nil
 }
 
 func NewPrivateKeyManager(priv []byte, algo string) (KeyManager, error) {
@@ -78,27 +102,36 @@ func (m *keyManager) Sign(data []byte) ([]byte, error) {
 	return m.privKey.Sign(data)
 }
 
-func (m *keyManager) recoveryFromMnemonic(mnemonic, hdPath, algoStr string) error {
+func recoveryFromMnemonic(mnemonic, bip39Passphrase string, hdPaths []string, algoStr string) ([]crypto.PrivKey, error) {
 	words := strings.Split(mnemonic, " ")
 	if len(words) != 12 && len(words) != 24 {
-		return fmt.Errorf("mnemonic length should either be 12 or 24")
+		return nil, fmt.Errorf("mnemonic length should either be 12 or 24")
+	}
+
+	for _, hdPath := range hdPaths {
+		if len(hdPath) > 0 {
+			if _, err := hd.NewParamsFromPath(hdPath); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	algo, err := hd.NewSigningAlgoFromString(algoStr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// create master key and derive first key for keyring
-	derivedPriv, err := algo.Derive()(mnemonic, defaultBIP39Passphrase, hdPath)
+	// create master key and derive the keys for keyring
+	derivedPrivs, err := hd.DeriveBatch(algo, mnemonic, bip39Passphrase, hdPaths)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	privKey := algo.Generate()(derivedPriv)
-	m.privKey = privKey
-	m.algo = algoStr
-	return nil
+	privKeys := make([]crypto.PrivKey, len(derivedPrivs))
+	for i, derivedPriv := range derivedPrivs {
+		privKeys[i] = algo.Generate()(derivedPriv)
+	}
+	return privKeys, nil
 }
 
 func (m *keyManager) ExportPrivKey(password string) (armor string, err error) {
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -23,3 +24,48 @@ func TestNewMnemonicKeyManager(t *testing.T) {
 	address := sdk.AccAddress(pubKey.Address()).String()
 	assert.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", address)
 }
+
+func TestNewMnemonicKeyManagerWithHDPath(t *testing.T) {
+	mnemonic := "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+	km, err := crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", "", hd.FullPath)
+	assert.NoError(t, err)
+	address := sdk.AccAddress(km.ExportPubKey().Address()).String()
+	assert.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", address)
+
+	km, err = crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", "", hd.CreateHDPath(hd.CoinType, 0, 1).String())
+	assert.NoError(t, err)
+	assert.NotEqual(t, address, sdk.AccAddress(km.ExportPubKey().Address()).String())
+
+	km, err = crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", "passphrase", hd.FullPath)
+	assert.NoError(t, err)
+	assert.NotEqual(t, address, sdk.AccAddress(km.ExportPubKey().Address()).String())
+
+	_, err = crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", "", "m/44'/118'/0'/0/0")
+	assert.Error(t, err)
+}
+
+func TestNewSm2KeyManager(t *testing.T) {
+	km, err := crypto.NewAlgoKeyManager("sm2")
+	assert.NoError(t, err)
+
+	mnemonic, privKey := km.Generate()
+	assert.Equal(t, "sm2", privKey.Type())
+
+	recovered, err := crypto.NewMnemonicKeyManager(mnemonic, "sm2")
+	assert.NoError(t, err)
+	assert.True(t, km.ExportPubKey().Equals(recovered.ExportPubKey()))
+
+	msg := []byte("hello")
+	sig, err := km.Sign(msg)
+	assert.NoError(t, err)
+	assert.True(t, recovered.ExportPubKey().VerifySignature(msg, sig))
+
+	armor, err := km.ExportPrivKey("12345678")
+	assert.NoError(t, err)
+	imported := crypto.NewKeyManager()
+	_, algo, err := imported.ImportPrivKey(armor, "12345678")
+	assert.NoError(t, err)
+	assert.Equal(t, "sm2", algo)
+	assert.True(t, km.ExportPubKey().Equals(imported.ExportPubKey()))
+}
@@ -0,0 +1,254 @@
+package sm2
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/tjfoc/gmsm/sm2"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cryptotypes "github.com/irisnet/irishub-sdk-go/crypto/types"
+)
+
+var _ cryptotypes.PrivKey = &PrivKey{}
+var _ codec.AminoMarshaler = &PrivKey{}
+
+const (
+	PrivKeySize = 32
+	// PubKeySize is comprised of 32 bytes for one field element
+	// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+	PubKeySize = 33
+	// SignatureSize is the size of a signature of the form R || S
+	SignatureSize = 64
+
+	keyType     = "sm2"
+	PrivKeyName = "tendermint/PrivKeySm2"
+	PubKeyName  = "tendermint/PubKeySm2"
+)
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// Sign produces a signature on the provided message with the default user id,
+// the message is hashed with SM3 by the signing algorithm itself.
+// The returned signature will be of the form R || S.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	r, s, err := sm2.Sm2Sign(privKey.GetPrivateKey(), msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rBytes := r.Bytes()
+	sBytes := s.Bytes()
+	sigBytes := make([]byte, SignatureSize)
+	// 0 pad the byte arrays from the left if they aren't big enough.
+	copy(sigBytes[32-len(rBytes):32], rBytes)
+	copy(sigBytes[64-len(sBytes):64], sBytes)
+	return sigBytes, nil
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the compressed pubkey.
+func (privKey *PrivKey) PubKey() crypto.PubKey {
+	priv := privKey.GetPrivateKey()
+	return &PubKey{Key: sm2.Compress(&priv.PublicKey)}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other crypto.PrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// GetPrivateKey converts the key bytes to a sm2.PrivateKey
+func (privKey *PrivKey) GetPrivateKey() *sm2.PrivateKey {
+	k := new(big.Int).SetBytes(privKey.Key)
+	c := sm2.P256Sm2()
+
+	priv := new(sm2.PrivateKey)
+	priv.PublicKey.Curve = c
+	priv.D = k
+	priv.PublicKey.X, priv.PublicKey.Y = c.ScalarBaseMult(k.Bytes())
+	return priv
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// GenPrivKey generates a new sm2 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	return &PrivKey{Key: genPrivKey(crypto.CReader())}
+}
+
+// genPrivKey generates a new sm2 private key using the provided reader.
+func genPrivKey(rand io.Reader) []byte {
+	var privKeyBytes [PrivKeySize]byte
+	d := new(big.Int)
+	for {
+		privKeyBytes = [PrivKeySize]byte{}
+		_, err := io.ReadFull(rand, privKeyBytes[:])
+		if err != nil {
+			panic(err)
+		}
+
+		d.SetBytes(privKeyBytes[:])
+		// break if we found a valid point (i.e. > 0 and < N == curverOrder)
+		isValidFieldElement := 0 < d.Sign() && d.Cmp(sm2.P256Sm2().Params().N) < 0
+		if isValidFieldElement {
+			break
+		}
+	}
+
+	return privKeyBytes[:]
+}
+
+var one = new(big.Int).SetInt64(1)
+
+// IsValidPrivKey tells if bz is a valid sm2 private key, i.e. a scalar in [1, N-1], N being the curve order
+func IsValidPrivKey(bz []byte) bool {
+	if len(bz) != PrivKeySize {
+		return false
+	}
+	d := new(big.Int).SetBytes(bz)
+	return d.Sign() > 0 && d.Cmp(sm2.P256Sm2().Params().N) < 0
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	secHash := sha256.Sum256(secret)
+
+	fe := new(big.Int).SetBytes(secHash[:])
+	n := new(big.Int).Sub(sm2.P256Sm2().Params().N, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	feB := fe.Bytes()
+	privKey32 := make([]byte, PrivKeySize)
+	// copy feB over to fixed 32 byte privKey32 and pad (if necessary)
+	copy(privKey32[32-len(feB):32], feB)
+
+	return &PrivKey{Key: privKey32}
+}
+
+//-------------------------------------
+
+var _ cryptotypes.PubKey = &PubKey{}
+var _ codec.AminoMarshaler = &PubKey{}
+
+// Address is the SHA256-20 of the compressed pubkey bytes.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies a signature of the form R || S with the default user id.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) (ok bool) {
+	if len(sig) != SignatureSize || len(pubKey.Key) != PubKeySize {
+		return false
+	}
+
+	// Decompress panics if the x-coordinate is not on the curve
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	publicKey := sm2.Decompress(pubKey.Key)
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	return sm2.Sm2Verify(publicKey, msg, nil, r, s)
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeySm2{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other crypto.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return fmt.Errorf("invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
@@ -0,0 +1,93 @@
+package sm2_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
+)
+
+func TestSignAndValidateSm2(t *testing.T) {
+	privKey := sm2.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), sm2.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, sm2.SignatureSize)
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[3] ^= byte(0x01)
+
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+// TestKnownVector checks the key pair and the signature of the example of GM/T 0003.5, signed with the
+// default user id 1234567812345678 used by IRIS Hub
+func TestKnownVector(t *testing.T) {
+	key, err := hex.DecodeString("3945208f7b2144b13f36e38ac6d39f95889393692860b51a42fb81ef4df7c5b8")
+	require.NoError(t, err)
+	privKey := &sm2.PrivKey{Key: key}
+
+	// the compressed public key of (09f9df31...56f35020, ccea490c...2da9ad13)
+	pubKey := privKey.PubKey()
+	assert.Equal(t, "0309f9df311e5421a150dd7d161e4bc5c672179fad1833fc076bb08ff356f35020", hex.EncodeToString(pubKey.Bytes()))
+	// the address is the SHA256-20 of the compressed public key
+	assert.Equal(t, "46316be088824e77a80c39547857850e1d19c8c4", hex.EncodeToString(pubKey.Address()))
+
+	sig, err := hex.DecodeString("f5a03b0648d2c4630eeac513e1bb81a15944da3827d5b74143ac7eaceee720b3" +
+		"b1b6aa29df212fd8763182bc0d421ca1bb9038fd1f7f42d4840b69c485bbc1aa")
+	require.NoError(t, err)
+	assert.True(t, pubKey.VerifySignature([]byte("message digest"), sig))
+	assert.False(t, pubKey.VerifySignature([]byte("message digesT"), sig))
+}
+
+func TestIsValidPrivKey(t *testing.T) {
+	order, err := hex.DecodeString("fffffffeffffffffffffffffffffffff7203df6b21c6052b53bbf40939d54123")
+	require.NoError(t, err)
+	assert.False(t, sm2.IsValidPrivKey(order))
+
+	order[31]--
+	assert.True(t, sm2.IsValidPrivKey(order))
+	assert.False(t, sm2.IsValidPrivKey(make([]byte, sm2.PrivKeySize)))
+	assert.False(t, sm2.IsValidPrivKey(order[1:]))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey1 := sm2.GenPrivKeyFromSecret([]byte("secret"))
+	privKey2 := sm2.GenPrivKeyFromSecret([]byte("secret"))
+	assert.True(t, privKey1.Equals(privKey2))
+	assert.True(t, privKey1.PubKey().Equals(privKey2.PubKey()))
+	assert.Equal(t, privKey1.PubKey().Address(), privKey2.PubKey().Address())
+}
+
+func TestSm2AminoRoundTrip(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(cdc)
+
+	privKey := sm2.GenPrivKey()
+	bz, err := cdc.MarshalBinaryBare(privKey)
+	require.NoError(t, err)
+
+	var priv crypto.PrivKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &priv))
+	assert.True(t, privKey.Equals(priv))
+
+	pubKey := privKey.PubKey()
+	bz, err = cdc.MarshalBinaryBare(pubKey)
+	require.NoError(t, err)
+
+	var pub crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &pub))
+	assert.True(t, pubKey.Equals(pub))
+}
@@ -0,0 +1,160 @@
+package sdk
+
+import (
+	"context"
+	"sort"
+
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+// maxTxSearchSize is the maximum number of txs per page of a tx search
+const maxTxSearchSize = 100
+
+// AccountHistory returns a page of the txs which touched the address: the txs sent by it, and the ones
+// matching the history queries of the registered modules, e.g. the transfers to the address.
+// The txs are deduplicated and ordered by height, and their msgs are parsed into the activities of the modules.
+//
+// The next page is returned by passing the Next cursor of a page as opts.After, which starts the queries
+// from the height of the cursor, so the cost of a page does not depend on its position in the history.
+func (client *IRISHUBClient) AccountHistory(address string, opts types.HistoryOptions) (types.AccountHistory, error) {
+	if _, err := types.AccAddressFromBech32(address); err != nil {
+		return types.AccountHistory{}, err
+	}
+
+	opts = opts.Normalize()
+	if err := opts.Order.Validate(); err != nil {
+		return types.AccountHistory{}, err
+	}
+
+	switch {
+	case opts.After != nil && opts.Order == types.OrderAsc:
+		if opts.FromHeight < opts.After.Height {
+			opts.FromHeight = opts.After.Height
+		}
+	case opts.After != nil:
+		if opts.ToHeight <= 0 || opts.ToHeight > opts.After.Height {
+			opts.ToHeight = opts.After.Height
+		}
+	case opts.ToHeight <= 0:
+		// pin the upper bound of the first page, so that all the queries see the same txs
+		// and the txs committed later do not show up in the next pages
+		status, err := client.Status(context.Background())
+		if err != nil {
+			return types.AccountHistory{}, err
+		}
+		opts.ToHeight = status.SyncInfo.LatestBlockHeight
+	}
+
+	modules := client.historyModules()
+	builders := []*types.EventQueryBuilder{
+		types.NewEventQueryBuilder().AddCondition(
+			types.NewCond(types.EventTypeMessage, types.AttributeKeySender).EQ(types.EventValue(address)),
+		),
+	}
+	for _, m := range modules {
+		builders = append(builders, m.HistoryQueries(address)...)
+	}
+
+	// the first size+1 txs of the merged result are among the first size+1 txs of every query
+	txs := make(map[string]types.ResultQueryTx)
+	for _, builder := range builders {
+		if err := client.searchHistory(builder.HeightRange(opts.FromHeight, opts.ToHeight), opts, txs); err != nil {
+			return types.AccountHistory{}, err
+		}
+	}
+
+	sorted := make([]types.ResultQueryTx, 0, len(txs))
+	for _, tx := range txs {
+		sorted = append(sorted, tx)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Height != sorted[j].Height {
+			return (sorted[i].Height < sorted[j].Height) == (opts.Order == types.OrderAsc)
+		}
+		return (sorted[i].Index < sorted[j].Index) == (opts.Order == types.OrderAsc)
+	})
+
+	history := types.AccountHistory{
+		Address: address,
+		Size:    opts.Size,
+		Entries: []types.HistoryEntry{},
+	}
+	if len(sorted) > opts.Size {
+		sorted = sorted[:opts.Size]
+		last := sorted[len(sorted)-1]
+		history.Next = &types.HistoryCursor{Height: last.Height, Index: last.Index}
+	}
+
+	for _, tx := range sorted {
+		entry := types.HistoryEntry{
+			Hash:      tx.Hash,
+			Height:    tx.Height,
+			Index:     tx.Index,
+			Timestamp: tx.Timestamp,
+			Code:      tx.Result.Code,
+		}
+		for _, msg := range tx.Tx.GetMsgs() {
+			entry.Activities = append(entry.Activities, parseActivity(modules, msg))
+		}
+		history.Entries = append(history.Entries, entry)
+	}
+	return history, nil
+}
+
+// searchHistory adds the first opts.Size+1 txs after opts.After matching the query to txs.
+// The heights of the query are bounded by the caller, so only the txs at the height of the
+// cursor which were returned by the previous pages are skipped.
+func (client *IRISHUBClient) searchHistory(builder *types.EventQueryBuilder, opts types.HistoryOptions, txs map[string]types.ResultQueryTx) error {
+	size := opts.Size + 1
+	if size > maxTxSearchSize {
+		size = maxTxSearchSize
+	}
+
+	found, fetched := 0, 0
+	for page := 1; found <= opts.Size; page++ {
+		res, err := client.SearchTxs(builder, page, size, opts.Order)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range res.Txs {
+			if opts.After != nil && opts.After.Passed(tx.Height, tx.Index, opts.Order) {
+				continue
+			}
+			if found > opts.Size {
+				break
+			}
+			txs[tx.Hash] = tx
+			found++
+		}
+
+		fetched += len(res.Txs)
+		if len(res.Txs) == 0 || fetched >= res.Total {
+			return nil
+		}
+	}
+	return nil
+}
+
+// historyModules returns the registered modules implementing types.HistoryModule, ordered by name
+func (client *IRISHUBClient) historyModules() []types.HistoryModule {
+	var modules []types.HistoryModule
+	for _, m := range client.moduleManager {
+		if hm, ok := m.(types.HistoryModule); ok {
+			modules = append(modules, hm)
+		}
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Name() < modules[j].Name()
+	})
+	return modules
+}
+
+func parseActivity(modules []types.HistoryModule, msg types.Msg) types.Activity {
+	for _, m := range modules {
+		if activity, ok := m.ParseActivity(msg); ok {
+			return activity
+		}
+	}
+	return types.MsgActivity{Msg: msg}
+}
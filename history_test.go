@@ -0,0 +1,175 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+const historyAddress = "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z"
+
+// historyTx is a tx of the fake chain
+type historyTx struct {
+	msgs []types.Msg
+}
+
+func (tx historyTx) GetMsgs() []types.Msg { return tx.msgs }
+func (tx historyTx) ValidateBasic() error { return nil }
+
+// historyChain fakes the tx search of a node, the txs are indexed by the conditions of the queries
+// matching them, the height conditions excluded
+type historyChain struct {
+	types.BaseClient
+	latest   int64
+	txs      map[string][]types.ResultQueryTx
+	searches int
+	statuses int
+}
+
+func (c *historyChain) Status(context.Context) (*ctypes.ResultStatus, error) {
+	c.statuses++
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: c.latest}}, nil
+}
+
+func (c *historyChain) SearchTxs(builder *types.EventQueryBuilder, page, size int, order types.Order) (types.ResultSearchTxs, error) {
+	c.searches++
+
+	var conditions []string
+	from, to := int64(0), c.latest
+	fromCond, toCond := string(types.TxHeightKey)+" >= ", string(types.TxHeightKey)+" <= "
+	for _, cond := range strings.Split(builder.Build(), " AND ") {
+		switch {
+		case strings.HasPrefix(cond, fromCond):
+			_, _ = fmt.Sscanf(cond, fromCond+"%d", &from)
+		case strings.HasPrefix(cond, toCond):
+			_, _ = fmt.Sscanf(cond, toCond+"%d", &to)
+		default:
+			conditions = append(conditions, cond)
+		}
+	}
+
+	var txs []types.ResultQueryTx
+	for _, tx := range c.txs[strings.Join(conditions, " AND ")] {
+		if tx.Height >= from && tx.Height <= to {
+			txs = append(txs, tx)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return (txs[i].Height < txs[j].Height) == (order == types.OrderAsc)
+		}
+		return (txs[i].Index < txs[j].Index) == (order == types.OrderAsc)
+	})
+
+	res := types.ResultSearchTxs{Total: len(txs)}
+	if begin := (page - 1) * size; begin < len(txs) {
+		end := begin + size
+		if end > len(txs) {
+			end = len(txs)
+		}
+		res.Txs = txs[begin:end]
+	}
+	return res, nil
+}
+
+func (c *historyChain) add(condition *types.EventQueryBuilder, height int64, index uint32) {
+	tx := types.ResultQueryTx{
+		Hash:   fmt.Sprintf("%d-%d", height, index),
+		Height: height,
+		Index:  index,
+		Tx: historyTx{msgs: []types.Msg{&bank.MsgSend{
+			FromAddress: historyAddress,
+			ToAddress:   historyAddress,
+		}}},
+	}
+	c.txs[condition.Build()] = append(c.txs[condition.Build()], tx)
+}
+
+func TestAccountHistory(t *testing.T) {
+	client := newTestClient(t)
+	chain := &historyChain{latest: 100, txs: make(map[string][]types.ResultQueryTx)}
+	client.BaseClient = chain
+
+	sent := types.NewEventQueryBuilder().AddCondition(
+		types.NewCond(types.EventTypeMessage, types.AttributeKeySender).EQ(types.EventValue(historyAddress)),
+	)
+	received := types.NewEventQueryBuilder().AddCondition(
+		types.NewCond("transfer", "recipient").EQ(types.EventValue(historyAddress)),
+	)
+
+	var expected []string
+	for height := int64(1); height <= 40; height++ {
+		switch {
+		case height%3 == 0:
+			// several txs of the address in one block
+			chain.add(sent, height, 0)
+			chain.add(received, height, 1)
+			chain.add(sent, height, 2)
+			expected = append(expected, fmt.Sprintf("%d-0", height), fmt.Sprintf("%d-1", height), fmt.Sprintf("%d-2", height))
+		case height%2 == 0:
+			// a tx sent to itself matches both queries
+			chain.add(sent, height, 0)
+			chain.add(received, height, 0)
+			expected = append(expected, fmt.Sprintf("%d-0", height))
+		}
+	}
+	// committed after the first page, it is only returned by the last page in ascending order,
+	// the pages of the descending order do not shift
+	chain.add(sent, 101, 0)
+
+	for _, order := range []types.Order{types.OrderAsc, types.OrderDesc} {
+		t.Run(string(order), func(t *testing.T) {
+			chain.searches, chain.statuses = 0, 0
+			opts := types.HistoryOptions{Size: 4, Order: order}
+
+			var hashes []string
+			searches := -1
+			for {
+				history, err := client.AccountHistory(historyAddress, opts)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(history.Entries), opts.Size)
+				for _, entry := range history.Entries {
+					hashes = append(hashes, entry.Hash)
+					require.Equal(t, bank.SendActivity{From: historyAddress, To: historyAddress}, entry.Activities[0])
+				}
+
+				// the cost of a page does not depend on its position: every query is searched once,
+				// and once more if the txs at the height of the cursor fill its first search
+				if searches < 0 {
+					searches = chain.searches
+				}
+				require.LessOrEqual(t, chain.searches, 2*searches)
+				chain.searches = 0
+
+				if history.Next == nil {
+					break
+				}
+				require.Len(t, history.Entries, opts.Size)
+				opts.After = history.Next
+				chain.latest = 101
+			}
+
+			want := append([]string{}, expected...)
+			if order == types.OrderAsc {
+				want = append(want, "101-0")
+			} else {
+				for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+					want[i], want[j] = want[j], want[i]
+				}
+			}
+			require.Equal(t, want, hashes)
+			// the upper bound is pinned by the first page only
+			require.Equal(t, 1, chain.statuses)
+			chain.latest = 100
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var _ Hook = DelegationHook{}
+
+// DelegationHook projects the staking msgs into the tables delegation_changes, every change of a delegation
+// with a signed amount, and delegations, the amount delegated by every delegator to every validator.
+// The amounts are the ones of the msgs, the slashes and the completion of the unbondings are not projected.
+type DelegationHook struct{}
+
+func (h DelegationHook) Name() string {
+	return staking.ModuleName
+}
+
+func (h DelegationHook) Schema() []string {
+	return []string{
+		`CREATE TABLE IF NOT EXISTS delegation_changes (
+			height BIGINT NOT NULL,
+			tx_index INTEGER NOT NULL,
+			msg_index INTEGER NOT NULL,
+			change_index INTEGER NOT NULL,
+			delegator TEXT NOT NULL,
+			validator TEXT NOT NULL,
+			denom TEXT NOT NULL,
+			amount TEXT NOT NULL,
+			PRIMARY KEY (height, tx_index, msg_index, change_index)
+		)`,
+		`CREATE INDEX IF NOT EXISTS delegation_changes_delegation ON delegation_changes (delegator, validator, denom)`,
+		`CREATE TABLE IF NOT EXISTS delegations (
+			delegator TEXT NOT NULL,
+			validator TEXT NOT NULL,
+			denom TEXT NOT NULL,
+			amount TEXT NOT NULL,
+			height BIGINT NOT NULL,
+			PRIMARY KEY (delegator, validator, denom)
+		)`,
+		`CREATE INDEX IF NOT EXISTS delegations_validator ON delegations (validator)`,
+	}
+}
+
+// delegationChange is a change of the amount delegated by a delegator to a validator
+type delegationChange struct {
+	delegator, validator, denom string
+	amount                      sdk.Int
+}
+
+func (h DelegationHook) HandleMsg(tx *Tx, msg Msg) error {
+	var changes []delegationChange
+	switch m := msg.Msg.(type) {
+	case *staking.MsgDelegate:
+		changes = []delegationChange{
+			{m.DelegatorAddress, m.ValidatorAddress, m.Amount.Denom, m.Amount.Amount},
+		}
+	case *staking.MsgUndelegate:
+		changes = []delegationChange{
+			{m.DelegatorAddress, m.ValidatorAddress, m.Amount.Denom, m.Amount.Amount.Neg()},
+		}
+	case *staking.MsgBeginRedelegate:
+		changes = []delegationChange{
+			{m.DelegatorAddress, m.ValidatorSrcAddress, m.Amount.Denom, m.Amount.Amount.Neg()},
+			{m.DelegatorAddress, m.ValidatorDstAddress, m.Amount.Denom, m.Amount.Amount},
+		}
+	default:
+		return nil
+	}
+
+	for i, change := range changes {
+		if _, err := tx.Exec(
+			"INSERT INTO delegation_changes (height, tx_index, msg_index, change_index, delegator, validator, denom, amount) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			msg.Height, msg.TxIndex, msg.MsgIndex, i, change.delegator, change.validator, change.denom, change.amount.String(),
+		); err != nil {
+			return err
+		}
+
+		var current string
+		err := tx.QueryRow(
+			"SELECT amount FROM delegations WHERE delegator = ? AND validator = ? AND denom = ?",
+			change.delegator, change.validator, change.denom,
+		).Scan(&current)
+		if err := ignoreNoRows(err); err != nil {
+			return err
+		}
+
+		amount, err := addAmount(current, change.amount)
+		if err != nil {
+			return err
+		}
+		if err := h.setAmount(tx, change, amount, msg.Height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback recomputes the delegations changed at or above height from their remaining changes
+func (h DelegationHook) Rollback(tx *Tx, height int64) error {
+	rows, err := tx.Query("SELECT DISTINCT delegator, validator, denom FROM delegation_changes WHERE height >= ?", height)
+	if err != nil {
+		return err
+	}
+
+	var delegations []delegationChange
+	for rows.Next() {
+		var d delegationChange
+		if err := rows.Scan(&d.delegator, &d.validator, &d.denom); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		delegations = append(delegations, d)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM delegation_changes WHERE height >= ?", height); err != nil {
+		return err
+	}
+
+	for _, d := range delegations {
+		rows, err := tx.Query(
+			"SELECT amount, height FROM delegation_changes WHERE delegator = ? AND validator = ? AND denom = ?",
+			d.delegator, d.validator, d.denom,
+		)
+		if err != nil {
+			return err
+		}
+
+		total := sdk.ZeroInt()
+		var latest int64
+		for rows.Next() {
+			var amount string
+			var changed int64
+			if err := rows.Scan(&amount, &changed); err != nil {
+				_ = rows.Close()
+				return err
+			}
+			if total, err = addAmount(amount, total); err != nil {
+				_ = rows.Close()
+				return err
+			}
+			if changed > latest {
+				latest = changed
+			}
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+
+		if err := h.setAmount(tx, d, total, latest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setAmount sets the amount of the delegation, the delegation is removed if the amount is not positive
+func (h DelegationHook) setAmount(tx *Tx, d delegationChange, amount sdk.Int, height int64) error {
+	if !amount.IsPositive() {
+		_, err := tx.Exec(
+			"DELETE FROM delegations WHERE delegator = ? AND validator = ? AND denom = ?",
+			d.delegator, d.validator, d.denom,
+		)
+		return err
+	}
+
+	_, err := tx.Exec(
+		"INSERT INTO delegations (delegator, validator, denom, amount, height) VALUES (?, ?, ?, ?, ?) "+
+			"ON CONFLICT (delegator, validator, denom) DO UPDATE SET amount = excluded.amount, height = excluded.height",
+		d.delegator, d.validator, d.denom, amount.String(), height,
+	)
+	return err
+}
+
+// addAmount adds delta to the amount stored as a string, an empty string is zero
+func addAmount(amount string, delta sdk.Int) (sdk.Int, error) {
+	if len(amount) == 0 {
+		return delta, nil
+	}
+
+	value, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid amount %s", amount)
+	}
+	return value.Add(delta), nil
+}
@@ -0,0 +1,32 @@
+package indexer
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// Hook projects the msgs of the indexed txs into the typed tables of a module, e.g. the owners of the nfts
+type Hook interface {
+	// Name returns the name of the hook, unique in an indexer
+	Name() string
+
+	// Schema returns the statements creating the tables of the hook, they must be idempotent
+	Schema() []string
+
+	// HandleMsg projects a msg of a successful tx, in the transaction indexing the block
+	HandleMsg(tx *Tx, msg Msg) error
+
+	// Rollback reverts the projection of the blocks at or above height, when they are replaced by a re-org
+	Rollback(tx *Tx, height int64) error
+}
+
+// Msg is a msg of an indexed tx
+type Msg struct {
+	Height   int64
+	Time     time.Time
+	TxHash   string
+	TxIndex  int
+	MsgIndex int
+	Msg      sdk.Msg
+}
@@ -0,0 +1,76 @@
+package indexer
+
+import (
+	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+)
+
+const (
+	htlcOpen      = "open"
+	htlcCompleted = "completed"
+	htlcRefunded  = "refunded"
+)
+
+var _ Hook = HTLCHook{}
+
+// HTLCHook projects the htlc msgs into the table htlcs, the state of every htlc by hash lock:
+// open once created, then completed when claimed or refunded. The expiration of an open htlc
+// is not projected, it can be computed from time_lock and created_height.
+type HTLCHook struct{}
+
+func (h HTLCHook) Name() string {
+	return htlc.ModuleName
+}
+
+func (h HTLCHook) Schema() []string {
+	return []string{
+		`CREATE TABLE IF NOT EXISTS htlcs (
+			hash_lock TEXT PRIMARY KEY,
+			sender TEXT NOT NULL,
+			receiver TEXT NOT NULL,
+			receiver_on_other_chain TEXT NOT NULL,
+			amount TEXT NOT NULL,
+			time_lock BIGINT NOT NULL,
+			state TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			created_height BIGINT NOT NULL,
+			updated_height BIGINT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS htlcs_sender ON htlcs (sender)`,
+		`CREATE INDEX IF NOT EXISTS htlcs_receiver ON htlcs (receiver)`,
+	}
+}
+
+func (h HTLCHook) HandleMsg(tx *Tx, msg Msg) error {
+	var err error
+	switch m := msg.Msg.(type) {
+	case *htlc.MsgCreateHTLC:
+		_, err = tx.Exec(
+			"INSERT INTO htlcs (hash_lock, sender, receiver, receiver_on_other_chain, amount, time_lock, state, secret, created_height, updated_height) "+
+				"VALUES (?, ?, ?, ?, ?, ?, ?, '', ?, ?)",
+			m.HashLock, m.Sender, m.To, m.ReceiverOnOtherChain, m.Amount.String(), m.TimeLock, htlcOpen, msg.Height, msg.Height,
+		)
+	case *htlc.MsgClaimHTLC:
+		_, err = tx.Exec(
+			"UPDATE htlcs SET state = ?, secret = ?, updated_height = ? WHERE hash_lock = ?",
+			htlcCompleted, m.Secret, msg.Height, m.HashLock,
+		)
+	case *htlc.MsgRefundHTLC:
+		_, err = tx.Exec(
+			"UPDATE htlcs SET state = ?, updated_height = ? WHERE hash_lock = ?",
+			htlcRefunded, msg.Height, m.HashLock,
+		)
+	}
+	return err
+}
+
+// Rollback deletes the htlcs created at or above height, and reopens the ones closed at or above height
+func (h HTLCHook) Rollback(tx *Tx, height int64) error {
+	if _, err := tx.Exec("DELETE FROM htlcs WHERE created_height >= ?", height); err != nil {
+		return err
+	}
+	_, err := tx.Exec(
+		"UPDATE htlcs SET state = ?, secret = '', updated_height = created_height WHERE updated_height >= ?",
+		htlcOpen, height,
+	)
+	return err
+}
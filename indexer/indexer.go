@@ -0,0 +1,379 @@
+// Package indexer persists the blocks, txs, msgs and events of the chain to a SQL database, and projects
+// the msgs of the modules into typed tables with hooks, e.g. NFTHook, HTLCHook and DelegationHook.
+//
+// The indexer works with any database/sql driver of SQLite or Postgres, which is imported by the caller:
+//
+//	import _ "github.com/mattn/go-sqlite3"
+//
+//	db, err := sql.Open("sqlite3", "chain.db")
+//	idx, err := indexer.New(client, db, indexer.Options{
+//		Hooks: []indexer.Hook{indexer.NFTHook{}, indexer.HTLCHook{}, indexer.DelegationHook{}},
+//	})
+//	err = idx.Run(ctx)
+//
+// Every block is indexed in a database transaction together with the checkpoint, so that an interrupted
+// indexer resumes from the last indexed block. If a block does not follow the last indexed one, the last
+// indexed block is rolled back and the indexing restarts from it.
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	defaultStartHeight   = 1
+	defaultMaxReorgDepth = 100
+
+	sourceBeginBlock = "begin_block"
+	sourceTx         = "tx"
+	sourceEndBlock   = "end_block"
+)
+
+// ErrReorg is returned by IndexBlock when the block does not follow the last indexed block,
+// which is rolled back, the indexing must restart from the new checkpoint
+var ErrReorg = errors.New("block does not follow the last indexed block")
+
+// Client is the client the blocks are fetched with, e.g. sdk.IRISHUBClient
+type Client interface {
+	StreamBlocks(ctx context.Context, from, to int64, opts sdk.StreamBlocksOptions, handler sdk.BlockHandler) error
+	MarshalProtoJSON(o proto.Message) ([]byte, error)
+}
+
+// Options are the options of an Indexer
+type Options struct {
+	// dialect of the database, SQLite by default
+	Dialect Dialect
+	// height the indexing starts from when the database is empty, 1 by default
+	StartHeight int64
+	// maximum number of blocks rolled back before the indexing gets past the height the re-org
+	// started from again, 100 by default
+	MaxReorgDepth int
+	// options of streaming the blocks
+	Stream sdk.StreamBlocksOptions
+	// hooks projecting the msgs into the tables of the modules
+	Hooks  []Hook
+	Logger log.Logger
+}
+
+// Checkpoint is the last indexed block
+type Checkpoint struct {
+	Height int64  `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+// Indexer indexes the blocks to a database, it must not be run concurrently on the same database
+type Indexer struct {
+	client Client
+	db     *sql.DB
+	opts   Options
+
+	// number of blocks rolled back since the re-org started from the height reorgTip,
+	// reset when a block above reorgTip is indexed
+	reorgDepth int
+	reorgTip   int64
+}
+
+// New returns an Indexer writing to db, the tables of the indexer and of the hooks are created if missing
+func New(client Client, db *sql.DB, opts Options) (*Indexer, error) {
+	if len(opts.Dialect) == 0 {
+		opts.Dialect = SQLite
+	}
+	if opts.Dialect != SQLite && opts.Dialect != Postgres {
+		return nil, fmt.Errorf("unsupported dialect %s", opts.Dialect)
+	}
+	if opts.StartHeight <= 0 {
+		opts.StartHeight = defaultStartHeight
+	}
+	if opts.MaxReorgDepth <= 0 {
+		opts.MaxReorgDepth = defaultMaxReorgDepth
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+
+	names := make(map[string]bool)
+	statements := append([]string{}, schema...)
+	for _, hook := range opts.Hooks {
+		if names[hook.Name()] {
+			return nil, fmt.Errorf("duplicate hook %s", hook.Name())
+		}
+		names[hook.Name()] = true
+		statements = append(statements, hook.Schema()...)
+	}
+
+	idx := &Indexer{client: client, db: db, opts: opts}
+	err := idx.inTx(context.Background(), func(tx *Tx) error {
+		for _, stmt := range statements {
+			if _, err := tx.Exec(stmt); err != nil {
+				return fmt.Errorf("create schema failed: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Run indexes the blocks from the checkpoint on, and keeps indexing the new blocks until ctx is done
+// or an error occurs
+func (idx *Indexer) Run(ctx context.Context) error {
+	for {
+		checkpoint, err := idx.Checkpoint(ctx)
+		if err != nil {
+			return err
+		}
+
+		from := idx.opts.StartHeight
+		if checkpoint.Height > 0 {
+			from = checkpoint.Height + 1
+		}
+
+		idx.opts.Logger.Info("indexing blocks", "from", from)
+		err = idx.client.StreamBlocks(ctx, from, 0, idx.opts.Stream, func(block sdk.BlockDetail) error {
+			return idx.IndexBlock(ctx, block)
+		})
+		if !errors.Is(err, ErrReorg) {
+			return err
+		}
+	}
+}
+
+// Checkpoint returns the last indexed block, a zero height if no block is indexed
+func (idx *Indexer) Checkpoint(ctx context.Context) (Checkpoint, error) {
+	var checkpoint Checkpoint
+	err := idx.inTx(ctx, func(tx *Tx) (err error) {
+		checkpoint, err = readCheckpoint(tx)
+		return err
+	})
+	return checkpoint, err
+}
+
+// IndexBlock indexes the block following the checkpoint, the blocks already indexed are skipped.
+// It returns ErrReorg if the block does not follow the last indexed block, which is rolled back.
+func (idx *Indexer) IndexBlock(ctx context.Context, block sdk.BlockDetail) error {
+	height := block.Block.Height
+	reorg := false
+	err := idx.inTx(ctx, func(tx *Tx) error {
+		checkpoint, err := readCheckpoint(tx)
+		if err != nil {
+			return err
+		}
+
+		if checkpoint.Height > 0 {
+			switch {
+			case height <= checkpoint.Height:
+				return nil
+			case height > checkpoint.Height+1:
+				return fmt.Errorf("block %d does not follow the checkpoint %d", height, checkpoint.Height)
+			case block.Block.LastBlockID.Hash.String() != checkpoint.Hash:
+				reorg = true
+				return idx.rollback(tx, checkpoint.Height)
+			}
+		}
+		return idx.writeBlock(tx, block)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !reorg {
+		if height > idx.reorgTip {
+			idx.reorgDepth, idx.reorgTip = 0, 0
+		}
+		return nil
+	}
+
+	if idx.reorgTip == 0 {
+		idx.reorgTip = height - 1
+	}
+	idx.reorgDepth++
+	idx.opts.Logger.Info("block rolled back", "height", height-1, "depth", idx.reorgDepth)
+	if idx.reorgDepth > idx.opts.MaxReorgDepth {
+		return fmt.Errorf("re-org deeper than %d blocks", idx.opts.MaxReorgDepth)
+	}
+	return ErrReorg
+}
+
+// Rollback deletes the blocks at or above height, the checkpoint goes back to the block below
+func (idx *Indexer) Rollback(ctx context.Context, height int64) error {
+	return idx.inTx(ctx, func(tx *Tx) error {
+		return idx.rollback(tx, height)
+	})
+}
+
+func (idx *Indexer) rollback(tx *Tx, height int64) error {
+	for _, table := range tables {
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE height >= ?", table), height); err != nil {
+			return err
+		}
+	}
+	for _, hook := range idx.opts.Hooks {
+		if err := hook.Rollback(tx, height); err != nil {
+			return fmt.Errorf("rollback hook %s failed: %w", hook.Name(), err)
+		}
+	}
+
+	var hash string
+	err := tx.QueryRow("SELECT hash FROM blocks WHERE height = ?", height-1).Scan(&hash)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		_, err = tx.Exec("DELETE FROM indexer_checkpoint")
+		return err
+	case err != nil:
+		return err
+	}
+	return writeCheckpoint(tx, Checkpoint{Height: height - 1, Hash: hash})
+}
+
+func (idx *Indexer) writeBlock(tx *Tx, block sdk.BlockDetail) error {
+	header := block.Block.Header
+	hash := block.BlockID.Hash.String()
+	if _, err := tx.Exec(
+		"INSERT INTO blocks (height, hash, time, proposer, num_txs) VALUES (?, ?, ?, ?, ?)",
+		header.Height, hash, header.Time.UTC(), sdk.ConsAddress(header.ProposerAddress).String(), len(block.Block.DecodedTxs),
+	); err != nil {
+		return err
+	}
+
+	results := block.BlockResult.Results
+	if err := writeEvents(tx, header.Height, sourceBeginBlock, -1, results.BeginBlock.Events); err != nil {
+		return err
+	}
+	if err := writeEvents(tx, header.Height, sourceEndBlock, -1, results.EndBlock.Events); err != nil {
+		return err
+	}
+
+	for i, stdTx := range block.Block.DecodedTxs {
+		var result sdk.TxResult
+		if i < len(results.DeliverTx) {
+			result = results.DeliverTx[i]
+		}
+
+		var memo string
+		if txWithMemo, ok := stdTx.(sdk.TxWithMemo); ok {
+			memo = txWithMemo.GetMemo()
+		}
+
+		var txHash string
+		if i < len(block.Block.Hashes) {
+			txHash = block.Block.Hashes[i]
+		}
+		if _, err := tx.Exec(
+			"INSERT INTO txs (height, tx_index, hash, code, log, gas_wanted, gas_used, memo, decoded) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			header.Height, i, txHash, result.Code, result.Log, result.GasWanted, result.GasUsed, memo, stdTx != nil,
+		); err != nil {
+			return err
+		}
+		if err := writeEvents(tx, header.Height, sourceTx, i, result.Events); err != nil {
+			return err
+		}
+		if stdTx == nil {
+			continue
+		}
+
+		for j, msg := range stdTx.GetMsgs() {
+			if err := idx.writeMsg(tx, header.Height, i, j, msg); err != nil {
+				return err
+			}
+			if result.Code != 0 {
+				continue
+			}
+
+			m := Msg{
+				Height:   header.Height,
+				Time:     header.Time.UTC(),
+				TxHash:   txHash,
+				TxIndex:  i,
+				MsgIndex: j,
+				Msg:      msg,
+			}
+			for _, hook := range idx.opts.Hooks {
+				if err := hook.HandleMsg(tx, m); err != nil {
+					return fmt.Errorf("hook %s failed: %w", hook.Name(), err)
+				}
+			}
+		}
+	}
+	return writeCheckpoint(tx, Checkpoint{Height: header.Height, Hash: hash})
+}
+
+func (idx *Indexer) writeMsg(tx *Tx, height int64, txIndex, msgIndex int, msg sdk.Msg) error {
+	var typeURL, body string
+	if dynamic, ok := msg.(*sdk.DynamicMsg); ok {
+		typeURL, body = dynamic.TypeURL, string(dynamic.JSON)
+	} else {
+		typeURL = "/" + proto.MessageName(msg)
+		bz, err := idx.client.MarshalProtoJSON(msg)
+		if err != nil {
+			return fmt.Errorf("marshal msg %s failed: %w", typeURL, err)
+		}
+		body = string(bz)
+	}
+
+	var signer string
+	if signers := msg.GetSigners(); len(signers) > 0 {
+		signer = signers[0].String()
+	}
+
+	_, err := tx.Exec(
+		"INSERT INTO messages (height, tx_index, msg_index, type_url, signer, body) VALUES (?, ?, ?, ?, ?, ?)",
+		height, txIndex, msgIndex, typeURL, signer, body,
+	)
+	return err
+}
+
+func writeEvents(tx *Tx, height int64, source string, txIndex int, events sdk.StringEvents) error {
+	for i, event := range events {
+		for j, attr := range event.Attributes {
+			if _, err := tx.Exec(
+				"INSERT INTO events (height, source, tx_index, event_index, attr_index, type, key, value) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+				height, source, txIndex, i, j, event.Type, attr.Key, attr.Value,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readCheckpoint(tx *Tx) (Checkpoint, error) {
+	var checkpoint Checkpoint
+	err := tx.QueryRow("SELECT height, hash FROM indexer_checkpoint WHERE id = 1").Scan(&checkpoint.Height, &checkpoint.Hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Checkpoint{}, nil
+	}
+	return checkpoint, err
+}
+
+func writeCheckpoint(tx *Tx, checkpoint Checkpoint) error {
+	_, err := tx.Exec(
+		"INSERT INTO indexer_checkpoint (id, height, hash) VALUES (1, ?, ?) "+
+			"ON CONFLICT (id) DO UPDATE SET height = excluded.height, hash = excluded.hash",
+		checkpoint.Height, checkpoint.Hash,
+	)
+	return err
+}
+
+// inTx runs fn in a database transaction, which is committed if fn succeeds
+func (idx *Indexer) inTx(ctx context.Context, fn func(tx *Tx) error) error {
+	sqlTx, err := idx.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(&Tx{ctx: ctx, tx: sqlTx, dialect: idx.opts.Dialect}); err != nil {
+		_ = sqlTx.Rollback()
+		return err
+	}
+	return sqlTx.Commit()
+}
@@ -0,0 +1,205 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	_ "modernc.org/sqlite"
+
+	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+	"github.com/irisnet/irishub-sdk-go/modules/nft"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var errStreamEnd = errors.New("end of the chain")
+
+// chain streams its blocks then returns errStreamEnd
+type chain struct {
+	blocks []sdk.BlockDetail
+}
+
+func (c *chain) StreamBlocks(ctx context.Context, from, to int64, opts sdk.StreamBlocksOptions, handler sdk.BlockHandler) error {
+	for height := from; height <= int64(len(c.blocks)); height++ {
+		if err := handler(c.blocks[height-1]); err != nil {
+			return err
+		}
+	}
+	return errStreamEnd
+}
+
+func (c *chain) MarshalProtoJSON(o proto.Message) ([]byte, error) {
+	return json.Marshal(o)
+}
+
+// append appends a block with a tx of msgs and the result code to the chain
+func (c *chain) append(hash string, code uint32, msgs ...sdk.Msg) {
+	height := int64(len(c.blocks) + 1)
+	var prev tmbytes.HexBytes
+	if height > 1 {
+		prev = c.blocks[height-2].BlockID.Hash
+	}
+
+	block := sdk.BlockDetail{
+		BlockID: tmtypes.BlockID{Hash: tmbytes.HexBytes(hash)},
+		Block: sdk.Block{
+			Header: tmtypes.Header{
+				Height:      height,
+				Time:        time.Unix(height, 0),
+				LastBlockID: tmtypes.BlockID{Hash: prev},
+			},
+		},
+		BlockResult: sdk.BlockResult{
+			Height: height,
+			Results: sdk.ABCIResponses{
+				BeginBlock: sdk.ResultBeginBlock{Events: sdk.StringEvents{
+					{Type: "mint", Attributes: []sdk.Attribute{{Key: "amount", Value: "100"}}},
+				}},
+			},
+		},
+	}
+	if len(msgs) > 0 {
+		block.Block.DecodedTxs = []sdk.Tx{&sdk.DynamicTx{Msgs: msgs, Memo: hash}}
+		block.Block.Hashes = []string{"TX" + hash}
+		block.BlockResult.Results.DeliverTx = []sdk.TxResult{{
+			Code:   code,
+			Events: sdk.StringEvents{{Type: "message", Attributes: []sdk.Attribute{{Key: "action", Value: hash}}}},
+		}}
+	}
+	c.blocks = append(c.blocks, block)
+}
+
+func TestIndexer(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	carol := sdk.AccAddress("carol_______________").String()
+	validator := sdk.ValAddress("validator___________").String()
+	coin := func(amount int64) sdk.Coin { return sdk.NewCoin("uiris", sdk.NewInt(amount)) }
+
+	c := &chain{}
+	c.append("A1", 0,
+		&nft.MsgMintNFT{DenomId: "cat", Id: "tom", Sender: alice, Recipient: alice},
+		&staking.MsgDelegate{DelegatorAddress: alice, ValidatorAddress: validator, Amount: coin(100)},
+	)
+	c.append("A2", 0,
+		&nft.MsgTransferNFT{DenomId: "cat", Id: "tom", Sender: alice, Recipient: bob},
+		&htlc.MsgCreateHTLC{Sender: alice, To: bob, Amount: sdk.NewCoins(coin(10)), HashLock: "lock", TimeLock: 50},
+	)
+	c.append("A3", 0,
+		&nft.MsgTransferNFT{DenomId: "cat", Id: "tom", Sender: bob, Recipient: carol},
+		&htlc.MsgClaimHTLC{Sender: bob, HashLock: "lock", Secret: "secret"},
+		&staking.MsgUndelegate{DelegatorAddress: alice, ValidatorAddress: validator, Amount: coin(40)},
+	)
+
+	idx, err := New(c, db, Options{Hooks: []Hook{NFTHook{}, HTLCHook{}, DelegationHook{}}})
+	require.NoError(t, err)
+	require.ErrorIs(t, idx.Run(context.Background()), errStreamEnd)
+
+	checkpoint, err := idx.Checkpoint(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{Height: 3, Hash: tmbytes.HexBytes("A3").String()}, checkpoint)
+
+	query := func(query string, args ...interface{}) (value string) {
+		require.NoError(t, db.QueryRow(query, args...).Scan(&value))
+		return value
+	}
+	owner := func() string { return query("SELECT owner FROM nft_owners WHERE denom_id = 'cat' AND token_id = 'tom'") }
+	state := func() string { return query("SELECT state FROM htlcs WHERE hash_lock = 'lock'") }
+	delegation := func() string { return query("SELECT amount FROM delegations WHERE delegator = ? AND validator = ?", alice, validator) }
+
+	require.Equal(t, carol, owner())
+	require.Equal(t, htlcCompleted, state())
+	require.Equal(t, "60", delegation())
+	require.Equal(t, "7", query("SELECT COUNT(*) FROM messages"))
+	require.Equal(t, alice, query("SELECT signer FROM messages WHERE height = 1 AND msg_index = 0"))
+	require.Equal(t, "A2", query("SELECT memo FROM txs WHERE hash = 'TXA2'"))
+	require.Equal(t, "6", query("SELECT COUNT(*) FROM events"))
+
+	// the chain is re-organized from the block 3, where the token is burnt by a failed tx
+	c.blocks = c.blocks[:2]
+	c.append("B3", 1, &nft.MsgBurnNFT{DenomId: "cat", Id: "tom", Sender: bob})
+	c.append("B4", 0, &staking.MsgBeginRedelegate{
+		DelegatorAddress:    alice,
+		ValidatorSrcAddress: validator,
+		ValidatorDstAddress: sdk.ValAddress("validator2__________").String(),
+		Amount:              coin(30),
+	})
+	require.ErrorIs(t, idx.Run(context.Background()), errStreamEnd)
+
+	checkpoint, err = idx.Checkpoint(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(4), checkpoint.Height)
+
+	require.Equal(t, bob, owner())
+	require.Equal(t, htlcOpen, state())
+	require.Equal(t, "70", delegation())
+	require.Equal(t, "B3", query("SELECT memo FROM txs WHERE height = 3"))
+	require.Equal(t, "1", query("SELECT code FROM txs WHERE height = 3"))
+	require.Equal(t, "2", query("SELECT COUNT(*) FROM delegations"))
+
+	require.NoError(t, idx.Rollback(context.Background(), 1))
+	checkpoint, err = idx.Checkpoint(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{}, checkpoint)
+	require.Equal(t, "0", query("SELECT COUNT(*) FROM nft_owners"))
+	require.Equal(t, "0", query("SELECT COUNT(*) FROM delegations"))
+}
+
+func TestReorgDepth(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	a, b := &chain{}, &chain{}
+	a.append("A1", 0)
+	a.append("A2", 0)
+	b.append("A1", 0)
+	b.append("B2", 0)
+	b.append("B3", 0)
+
+	idx, err := New(a, db, Options{MaxReorgDepth: 2})
+	require.NoError(t, err)
+	index := func(block sdk.BlockDetail) error { return idx.IndexBlock(context.Background(), block) }
+	require.NoError(t, index(a.blocks[0]))
+	require.NoError(t, index(a.blocks[1]))
+
+	// the node flips between the forks, the block 2 is rolled back again and again
+	require.ErrorIs(t, index(b.blocks[2]), ErrReorg)
+	require.NoError(t, index(a.blocks[1]))
+	require.ErrorIs(t, index(b.blocks[2]), ErrReorg)
+	require.NoError(t, index(a.blocks[1]))
+	err = index(b.blocks[2])
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrReorg)
+
+	// the depth is reset once the indexing gets past the height the re-org started from
+	idx, err = New(a, db, Options{MaxReorgDepth: 2})
+	require.NoError(t, err)
+	require.NoError(t, index(a.blocks[1]))
+	require.ErrorIs(t, index(b.blocks[2]), ErrReorg)
+	require.NoError(t, index(b.blocks[1]))
+	require.NoError(t, index(b.blocks[2]))
+	require.Equal(t, 0, idx.reorgDepth)
+}
+
+func TestRebind(t *testing.T) {
+	query := "SELECT * FROM txs WHERE height = ? AND tx_index = ?"
+	require.Equal(t, query, SQLite.Rebind(query))
+	require.Equal(t, "SELECT * FROM txs WHERE height = $1 AND tx_index = $2", Postgres.Rebind(query))
+
+	query = `SELECT "a?" FROM txs WHERE memo = 'why?' AND log = 'it''s ?' AND height = ?`
+	require.Equal(t, `SELECT "a?" FROM txs WHERE memo = 'why?' AND log = 'it''s ?' AND height = $1`, Postgres.Rebind(query))
+}
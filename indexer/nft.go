@@ -0,0 +1,119 @@
+package indexer
+
+import (
+	"github.com/irisnet/irishub-sdk-go/modules/nft"
+)
+
+var _ Hook = NFTHook{}
+
+// NFTHook projects the nft msgs into the tables nft_transfers, every mint, transfer and burn of a token,
+// and nft_owners, the current owner of every token which is not burnt
+type NFTHook struct{}
+
+func (h NFTHook) Name() string {
+	return nft.ModuleName
+}
+
+func (h NFTHook) Schema() []string {
+	return []string{
+		`CREATE TABLE IF NOT EXISTS nft_transfers (
+			height BIGINT NOT NULL,
+			tx_index INTEGER NOT NULL,
+			msg_index INTEGER NOT NULL,
+			denom_id TEXT NOT NULL,
+			token_id TEXT NOT NULL,
+			action TEXT NOT NULL,
+			sender TEXT NOT NULL,
+			recipient TEXT NOT NULL,
+			PRIMARY KEY (height, tx_index, msg_index)
+		)`,
+		`CREATE INDEX IF NOT EXISTS nft_transfers_token ON nft_transfers (denom_id, token_id)`,
+		`CREATE TABLE IF NOT EXISTS nft_owners (
+			denom_id TEXT NOT NULL,
+			token_id TEXT NOT NULL,
+			owner TEXT NOT NULL,
+			height BIGINT NOT NULL,
+			PRIMARY KEY (denom_id, token_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS nft_owners_owner ON nft_owners (owner)`,
+	}
+}
+
+func (h NFTHook) HandleMsg(tx *Tx, msg Msg) error {
+	var denomID, tokenID, action, sender, recipient string
+	switch m := msg.Msg.(type) {
+	case *nft.MsgMintNFT:
+		denomID, tokenID, action, sender, recipient = m.DenomId, m.Id, "mint", m.Sender, m.Recipient
+	case *nft.MsgTransferNFT:
+		denomID, tokenID, action, sender, recipient = m.DenomId, m.Id, "transfer", m.Sender, m.Recipient
+	case *nft.MsgBurnNFT:
+		denomID, tokenID, action, sender = m.DenomId, m.Id, "burn", m.Sender
+	default:
+		return nil
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO nft_transfers (height, tx_index, msg_index, denom_id, token_id, action, sender, recipient) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		msg.Height, msg.TxIndex, msg.MsgIndex, denomID, tokenID, action, sender, recipient,
+	); err != nil {
+		return err
+	}
+	return h.setOwner(tx, denomID, tokenID, recipient, msg.Height)
+}
+
+// Rollback restores the owners of the tokens changed at or above height from their remaining transfers
+func (h NFTHook) Rollback(tx *Tx, height int64) error {
+	rows, err := tx.Query("SELECT DISTINCT denom_id, token_id FROM nft_transfers WHERE height >= ?", height)
+	if err != nil {
+		return err
+	}
+
+	var tokens [][2]string
+	for rows.Next() {
+		var token [2]string
+		if err := rows.Scan(&token[0], &token[1]); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM nft_transfers WHERE height >= ?", height); err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		var recipient string
+		var latest int64
+		err := tx.QueryRow(
+			"SELECT recipient, height FROM nft_transfers WHERE denom_id = ? AND token_id = ? "+
+				"ORDER BY height DESC, tx_index DESC, msg_index DESC LIMIT 1",
+			token[0], token[1],
+		).Scan(&recipient, &latest)
+		if err := ignoreNoRows(err); err != nil {
+			return err
+		}
+		if err := h.setOwner(tx, token[0], token[1], recipient, latest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setOwner sets the owner of the token, the token is removed if the owner is empty
+func (h NFTHook) setOwner(tx *Tx, denomID, tokenID, owner string, height int64) error {
+	if len(owner) == 0 {
+		_, err := tx.Exec("DELETE FROM nft_owners WHERE denom_id = ? AND token_id = ?", denomID, tokenID)
+		return err
+	}
+
+	_, err := tx.Exec(
+		"INSERT INTO nft_owners (denom_id, token_id, owner, height) VALUES (?, ?, ?, ?) "+
+			"ON CONFLICT (denom_id, token_id) DO UPDATE SET owner = excluded.owner, height = excluded.height",
+		denomID, tokenID, owner, height,
+	)
+	return err
+}
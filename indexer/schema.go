@@ -0,0 +1,57 @@
+package indexer
+
+// schema creates the tables of the blocks, txs, msgs and events, the statements are valid for SQLite and Postgres.
+// The events of a tx have the source "tx", the ones of the block have the source "begin_block" or "end_block"
+// and the tx index -1.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS indexer_checkpoint (
+		id INTEGER PRIMARY KEY,
+		height BIGINT NOT NULL,
+		hash TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		time TIMESTAMP NOT NULL,
+		proposer TEXT NOT NULL,
+		num_txs INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS txs (
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		hash TEXT NOT NULL,
+		code INTEGER NOT NULL,
+		log TEXT NOT NULL,
+		gas_wanted BIGINT NOT NULL,
+		gas_used BIGINT NOT NULL,
+		memo TEXT NOT NULL,
+		decoded BOOLEAN NOT NULL,
+		PRIMARY KEY (height, tx_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS txs_hash ON txs (hash)`,
+	`CREATE TABLE IF NOT EXISTS messages (
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		msg_index INTEGER NOT NULL,
+		type_url TEXT NOT NULL,
+		signer TEXT NOT NULL,
+		body TEXT NOT NULL,
+		PRIMARY KEY (height, tx_index, msg_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS messages_signer ON messages (signer)`,
+	`CREATE TABLE IF NOT EXISTS events (
+		height BIGINT NOT NULL,
+		source TEXT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		event_index INTEGER NOT NULL,
+		attr_index INTEGER NOT NULL,
+		type TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (height, source, tx_index, event_index, attr_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS events_attribute ON events (type, key, value)`,
+}
+
+// tables are the tables of the schema holding the rows of the blocks, deleted on a rollback
+var tables = []string{"events", "messages", "txs", "blocks"}
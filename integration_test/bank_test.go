@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/irisnet/irishub-sdk-go/modules/bank"
@@ -29,6 +30,26 @@ func (s IntegrationTestSuite) TestBank() {
 			"TestSimulate",
 			simulate,
 		},
+		{
+			"TestConcurrentSend",
+			concurrentSend,
+		},
+		{
+			"TestSendMsgs",
+			sendMsgs,
+		},
+		{
+			"TestSearchTxs",
+			searchTxs,
+		},
+		{
+			"TestAccountHistory",
+			accountHistory,
+		},
+		{
+			"TestStreamBlocks",
+			streamBlocks,
+		},
 		{
 			"TestSendWitchSpecAccountInfo",
 			sendWitchSpecAccountInfo,
@@ -138,6 +159,36 @@ func multiSend(s IntegrationTestSuite) {
 	fmt.Printf("total senconds:%s\n", end.Sub(begin).String())
 }
 
+func concurrentSend(s IntegrationTestSuite) {
+	coins, err := types.ParseDecCoins("1iris")
+	s.NoError(err)
+	to := s.GetRandAccount().Address.String()
+
+	// all the transactions are sent from one account without waiting for each other
+	var wait sync.WaitGroup
+	hashes := make([]string, 20)
+	for i := range hashes {
+		wait.Add(1)
+		go func(i int) {
+			defer wait.Done()
+			res, err := s.Bank.Send(to, coins, types.BaseTx{
+				From:     s.Account().Name,
+				Password: s.Account().Password,
+				Gas:      200000,
+				Memo:     "test",
+				Mode:     types.Sync,
+			})
+			s.NoError(err)
+			hashes[i] = res.Hash
+		}(i)
+	}
+	wait.Wait()
+
+	for _, hash := range hashes {
+		s.NotEmpty(hash)
+	}
+}
+
 func simulate(s IntegrationTestSuite) {
 	coins, err := types.ParseDecCoins("10iris")
 	s.NoError(err)
@@ -157,6 +208,91 @@ func simulate(s IntegrationTestSuite) {
 	fmt.Println(result)
 }
 
+func sendMsgs(s IntegrationTestSuite) {
+	coins, err := types.ParseDecCoins("10iris")
+	s.NoError(err)
+	amt, err := s.ToMinCoin(coins...)
+	s.NoError(err)
+
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Fee:      coins,
+		Memo:     "TEST",
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+
+	msg := &bank.MsgSend{
+		FromAddress: s.Account().Address.String(),
+		ToAddress:   s.GetRandAccount().Address.String(),
+		Amount:      amt,
+	}
+	res, err := s.SendMsgs([]types.Msg{msg}, baseTx)
+	s.NoError(err)
+	s.NotEmpty(res.Hash)
+
+	tx, err := s.QueryTx(res.Hash)
+	s.NoError(err)
+	s.Equal(msg, tx.Tx.GetMsgs()[0])
+
+	bz, err := s.MarshalTxJSON(tx.Tx)
+	s.NoError(err)
+	s.Contains(string(bz), "/cosmos.bank.v1beta1.MsgSend")
+
+	_, err = s.SendMsgs(nil, baseTx)
+	s.Error(err)
+}
+
+func searchTxs(s IntegrationTestSuite) {
+	builder := types.NewEventQueryBuilder().
+		AddCondition(types.NewCond(types.EventTypeMessage, types.AttributeKeySender).EQ(types.EventValue(s.Account().Address.String())))
+
+	res, err := s.SearchTxs(builder, 1, 10, types.OrderDesc)
+	s.NoError(err)
+	s.NotEmpty(res.Txs)
+	for i := 1; i < len(res.Txs); i++ {
+		s.GreaterOrEqual(res.Txs[i-1].Height, res.Txs[i].Height)
+	}
+
+	it := s.IterateTxs(builder, 5, types.OrderDesc)
+	var count int
+	for it.Next() {
+		s.NotEmpty(it.Tx().Timestamp)
+		count++
+	}
+	s.NoError(it.Err())
+	s.Equal(it.Total(), count)
+}
+
+func accountHistory(s IntegrationTestSuite) {
+	address := s.Account().Address.String()
+	history, err := s.AccountHistory(address, types.HistoryOptions{Size: 5})
+	s.NoError(err)
+	s.NotEmpty(history.Entries)
+	s.LessOrEqual(len(history.Entries), 5)
+
+	for i, entry := range history.Entries {
+		if i > 0 {
+			s.GreaterOrEqual(history.Entries[i-1].Height, entry.Height)
+		}
+		s.NotEmpty(entry.Activities)
+		for _, activity := range entry.Activities {
+			if send, ok := activity.(bank.SendActivity); ok {
+				s.True(send.From == address || send.To == address)
+			}
+		}
+	}
+
+	if history.Next != nil {
+		next, err := s.AccountHistory(address, types.HistoryOptions{Size: 5, After: history.Next})
+		s.NoError(err)
+		s.NotEmpty(next.Entries)
+		s.True(history.Next.Passed(history.Entries[len(history.Entries)-1].Height, history.Entries[len(history.Entries)-1].Index, types.OrderDesc))
+		s.False(history.Next.Passed(next.Entries[0].Height, next.Entries[0].Index, types.OrderDesc))
+	}
+}
+
 func sendWitchSpecAccountInfo(s IntegrationTestSuite) {
 	for i := 0; i < 10; i++ {
 		coins, err := types.ParseDecCoins("10iris")
@@ -182,3 +318,36 @@ func sendWitchSpecAccountInfo(s IntegrationTestSuite) {
 		require.NotEmpty(s.T(), res.Hash)
 	}
 }
+
+func streamBlocks(s IntegrationTestSuite) {
+	status, err := s.Status(context.Background())
+	s.NoError(err)
+	latest := status.SyncInfo.LatestBlockHeight
+	from := latest - 10
+	if from < 1 {
+		from = 1
+	}
+
+	next := from
+	err = s.StreamBlocks(context.Background(), from, latest, types.StreamBlocksOptions{Concurrency: 3}, func(block types.BlockDetail) error {
+		s.Equal(next, block.Block.Height)
+		next++
+		return nil
+	})
+	s.NoError(err)
+	s.Equal(latest+1, next)
+
+	// the live tail delivers the blocks produced after the latest one
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	next = latest
+	err = s.StreamBlocks(ctx, latest, 0, types.StreamBlocksOptions{}, func(block types.BlockDetail) error {
+		s.Equal(next, block.Block.Height)
+		next++
+		if next > latest+2 {
+			cancel()
+		}
+		return nil
+	})
+	s.ErrorIs(err, context.Canceled)
+}
@@ -1,11 +1,14 @@
 package integration_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
 	"github.com/irisnet/irishub-sdk-go/modules/gov"
 	"github.com/irisnet/irishub-sdk-go/types"
 	"github.com/stretchr/testify/require"
+	"time"
 )
 
 func (s IntegrationTestSuite) TestGov() {
@@ -19,6 +22,26 @@ func (s IntegrationTestSuite) TestGov() {
 			"TestParams",
 			testParams,
 		},
+
+		{
+			"TestContentProposals",
+			testContentProposals,
+		},
+
+		{
+			"TestWatchProposals",
+			testWatchProposals,
+		},
+
+		{
+			"TestWeightedVotes",
+			testWeightedVotes,
+		},
+
+		{
+			"TestQueryPages",
+			testQueryPages,
+		},
 	}
 
 	for _, t := range cases {
@@ -127,3 +150,250 @@ func testParams(s IntegrationTestSuite) {
 		fmt.Println(string(bz))
 	}
 }
+
+func testContentProposals(s IntegrationTestSuite) {
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      300000,
+		Memo:     "TEST",
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+
+	proposalId, _, err := s.Gov.SubmitParameterChangeProposal(gov.ParameterChangeProposalRequest{
+		Title:       s.RandStringOfLength(4),
+		Description: s.RandStringOfLength(6),
+		Changes: []gov.ParamChange{
+			{Subspace: "staking", Key: "MaxValidators", Value: "105"},
+		},
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	proposal, err := s.Gov.QueryProposal(proposalId)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), gov.ProposalTypeParameterChange, proposal.Content.ProposalType())
+
+	proposalId, _, err = s.Gov.SubmitSoftwareUpgradeProposal(gov.SoftwareUpgradeProposalRequest{
+		Title:       s.RandStringOfLength(4),
+		Description: s.RandStringOfLength(6),
+		Plan:        gov.Plan{Name: s.RandStringOfLength(6), Height: 1000000, Info: "upgrade info"},
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	proposal, err = s.Gov.QueryProposal(proposalId)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), gov.ProposalTypeSoftwareUpgrade, proposal.Content.ProposalType())
+
+	_, _, err = s.Gov.SubmitSoftwareUpgradeProposal(gov.SoftwareUpgradeProposalRequest{
+		Title:       s.RandStringOfLength(4),
+		Description: s.RandStringOfLength(6),
+		Plan:        gov.Plan{Name: s.RandStringOfLength(6)},
+	}, baseTx)
+	require.Error(s.T(), err)
+
+	amount, e := types.ParseDecCoins("1iris")
+	require.NoError(s.T(), e)
+	proposalId, _, err = s.Gov.SubmitCommunityPoolSpendProposal(gov.CommunityPoolSpendProposalRequest{
+		Title:       s.RandStringOfLength(4),
+		Description: s.RandStringOfLength(6),
+		Recipient:   s.GetRandAccount().Address.String(),
+		Amount:      amount,
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	proposal, err = s.Gov.QueryProposal(proposalId)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), gov.ProposalTypeCommunityPoolSpend, proposal.Content.ProposalType())
+}
+
+func testWatchProposals(s IntegrationTestSuite) {
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "TEST",
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	events := make(chan gov.ProposalEvent, 16)
+	go func() {
+		_ = s.Gov.WatchProposals(ctx, gov.WatchOptions{Voters: []string{s.Account().Address.String()}}, func(event gov.ProposalEvent) {
+			if event.Height > 0 {
+				events <- event
+			}
+		})
+	}()
+	time.Sleep(2 * time.Second)
+
+	deposit, e := types.ParseDecCoins("2000iris")
+	require.NoError(s.T(), e)
+	proposalId, _, err := s.Gov.SubmitProposal(gov.SubmitProposalRequest{
+		Title:          s.RandStringOfLength(4),
+		Description:    s.RandStringOfLength(6),
+		Type:           gov.ProposalTypeText,
+		InitialDeposit: deposit,
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	_, err = s.Gov.Vote(gov.VoteRequest{ProposalId: proposalId, Option: "VOTE_OPTION_YES"}, baseTx)
+	require.NoError(s.T(), err)
+
+	var received []gov.ProposalEventType
+	for len(received) < 3 {
+		select {
+		case event := <-events:
+			if event.ProposalId == proposalId {
+				received = append(received, event.Type)
+			}
+		case <-ctx.Done():
+			s.FailNow("proposal events not received", "%v", received)
+		}
+	}
+	require.Equal(s.T(), []gov.ProposalEventType{gov.ProposalSubmitted, gov.ProposalVotingStarted, gov.ProposalVoted}, received)
+}
+
+func testWeightedVotes(s IntegrationTestSuite) {
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      300000,
+		Memo:     "TEST",
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+
+	deposit, e := types.ParseDecCoins("2000iris")
+	require.NoError(s.T(), e)
+	var proposalIds []uint64
+	for i := 0; i < 3; i++ {
+		proposalId, _, err := s.Gov.SubmitProposal(gov.SubmitProposalRequest{
+			Title:          s.RandStringOfLength(4),
+			Description:    s.RandStringOfLength(6),
+			Type:           gov.ProposalTypeText,
+			InitialDeposit: deposit,
+		}, baseTx)
+		require.NoError(s.T(), err)
+		proposalIds = append(proposalIds, proposalId)
+	}
+	voter := s.Account().Address.String()
+
+	_, err := s.Gov.WeightedVote(gov.WeightedVoteRequest{
+		ProposalId: proposalIds[0],
+		Options: []gov.WeightedVoteOptionRequest{
+			{Option: "VOTE_OPTION_YES", Weight: types.NewDecWithPrec(7, 1)},
+			{Option: "VOTE_OPTION_ABSTAIN", Weight: types.NewDecWithPrec(3, 1)},
+		},
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	vote, err := s.Gov.QueryVote(proposalIds[0], voter)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), vote.Options, 2)
+
+	_, err = s.Gov.WeightedVote(gov.WeightedVoteRequest{
+		ProposalId: proposalIds[0],
+		Options: []gov.WeightedVoteOptionRequest{
+			{Option: "VOTE_OPTION_YES", Weight: types.NewDecWithPrec(7, 1)},
+		},
+	}, baseTx)
+	require.Error(s.T(), err)
+
+	_, err = s.Gov.VoteMany([]gov.VoteRequest{
+		{ProposalId: proposalIds[1], Option: "VOTE_OPTION_YES"},
+		{ProposalId: proposalIds[2], Option: "VOTE_OPTION_NO"},
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	vote, err = s.Gov.QueryVote(proposalIds[2], voter)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int32(gov.OptionNo), vote.Option)
+
+	// the validator grants a new account to vote on its behalf
+	name, password := s.RandStringOfLength(10), "1234567890"
+	grantee, _, err := s.Key.Add(name, password)
+	require.NoError(s.T(), err)
+
+	amount, e := types.ParseDecCoins("10iris")
+	require.NoError(s.T(), e)
+	_, err = s.Bank.Send(grantee, amount, baseTx)
+	require.NoError(s.T(), err)
+
+	msgTypeURL := authz.MsgTypeURL(&gov.MsgVote{})
+	_, err = s.Authz.Grant(authz.GrantRequest{
+		Grantee:    grantee,
+		MsgTypeURL: msgTypeURL,
+		Expiration: time.Now().Add(time.Hour),
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	grants, err := s.Authz.QueryGrants(voter, grantee, msgTypeURL)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), grants, 1)
+
+	_, err = s.Gov.VoteOnBehalf(voter, []gov.VoteRequest{
+		{ProposalId: proposalIds[1], Option: "VOTE_OPTION_ABSTAIN"},
+	}, types.BaseTx{
+		From:     name,
+		Gas:      200000,
+		Memo:     "TEST",
+		Mode:     types.Commit,
+		Password: password,
+	})
+	require.NoError(s.T(), err)
+
+	vote, err = s.Gov.QueryVote(proposalIds[1], voter)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int32(gov.OptionAbstain), vote.Option)
+}
+
+func testQueryPages(s IntegrationTestSuite) {
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "TEST",
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+
+	deposit, e := types.ParseDecCoins("2000iris")
+	require.NoError(s.T(), e)
+	proposalId, _, err := s.Gov.SubmitProposal(gov.SubmitProposalRequest{
+		Title:          s.RandStringOfLength(4),
+		Description:    s.RandStringOfLength(6),
+		Type:           gov.ProposalTypeText,
+		InitialDeposit: deposit,
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	_, err = s.Gov.Vote(gov.VoteRequest{ProposalId: proposalId, Option: "VOTE_OPTION_YES"}, baseTx)
+	require.NoError(s.T(), err)
+
+	voter := s.Account().Address.String()
+	proposals, err := s.Gov.QueryProposalsPage(gov.QueryProposalsReq{
+		Status: "PROPOSAL_STATUS_VOTING_PERIOD",
+		Voter:  voter,
+		Page:   1,
+		Size:   1,
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), proposals.Proposals, 1)
+	require.GreaterOrEqual(s.T(), proposals.Total, uint64(1))
+
+	votes, err := s.Gov.QueryVotesPage(proposalId, 1, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), votes.Votes, 1)
+	require.Equal(s.T(), voter, votes.Votes[0].Voter)
+
+	deposits, err := s.Gov.QueryDepositsPage(proposalId, 1, 10)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint64(1), deposits.Total)
+
+	tally, err := s.Gov.QueryLiveTally(proposalId)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "PROPOSAL_STATUS_VOTING_PERIOD", tally.Status)
+	require.True(s.T(), tally.Turnout.IsPositive())
+	require.True(s.T(), tally.ThresholdReached)
+	require.False(s.T(), tally.Vetoed)
+}
@@ -0,0 +1,58 @@
+package integration_test
+
+import (
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+	"github.com/irisnet/irishub-sdk-go/modules/keys"
+)
+
+func (s IntegrationTestSuite) TestKeys() {
+	cases := []SubTest{
+		{
+			"TestDerive",
+			derive,
+		},
+		{
+			"TestSignArbitrary",
+			signArbitrary,
+		},
+	}
+
+	for _, t := range cases {
+		s.Run(t.testName, func() {
+			t.testCase(s)
+		})
+	}
+}
+
+func derive(s IntegrationTestSuite) {
+	name, password := s.RandStringOfLength(10), s.RandStringOfLength(16)
+	address, mnemonic, err := s.Key.Add(name, password)
+	s.NoError(err)
+
+	accounts, err := s.Key.Derive(mnemonic, "", keys.DeriveParams{Count: 5})
+	s.NoError(err)
+	s.Len(accounts, 5)
+	s.Equal(address, accounts[0].Address)
+	s.Equal(hd.FullPath, accounts[0].HDPath)
+
+	name = s.RandStringOfLength(10)
+	recovered, err := s.Key.RecoverWithHDPath(name, password, mnemonic, "", accounts[3].HDPath)
+	s.NoError(err)
+	s.Equal(accounts[3].Address, recovered)
+}
+
+func signArbitrary(s IntegrationTestSuite) {
+	data := []byte("login nonce: 123456")
+	signature, err := s.Key.SignArbitrary(s.Account().Name, s.Account().Password, data)
+	s.NoError(err)
+	s.NotEmpty(signature.Signature)
+
+	err = s.Key.VerifyArbitrary(s.Account().Address.String(), data, signature)
+	s.NoError(err)
+
+	err = s.Key.VerifyArbitrary(s.Account().Address.String(), []byte("login nonce: 654321"), signature)
+	s.Error(err)
+
+	err = s.Key.VerifyArbitrary(s.GetRandAccount().Address.String(), data, signature)
+	s.Error(err)
+}
@@ -1,6 +1,8 @@
 package integration_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/irisnet/irishub-sdk-go/modules/nft"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
@@ -128,3 +130,139 @@ func (s IntegrationTestSuite) TestNFT() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), uint64(0), supply)
 }
+
+func (s IntegrationTestSuite) TestNFTPages() {
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "test",
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+
+	denomID := strings.ToLower(s.RandStringOfLength(4))
+	_, err := s.NFT.IssueDenom(nft.IssueDenomRequest{
+		ID:     denomID,
+		Name:   strings.ToLower(s.RandStringOfLength(4)),
+		Schema: strings.ToLower(s.RandStringOfLength(10)),
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	owner := s.Account().Address.String()
+	var tokenIDs []string
+	for i := 0; i < 3; i++ {
+		tokenID := strings.ToLower(s.RandStringOfLength(7))
+		_, err = s.NFT.MintNFT(nft.MintNFTRequest{
+			Denom: denomID,
+			ID:    tokenID,
+			Name:  strings.ToLower(s.RandStringOfLength(7)),
+			URI:   fmt.Sprintf("https://%s", s.RandStringOfLength(10)),
+		}, baseTx)
+		require.NoError(s.T(), err)
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+
+	page, err := s.NFT.QueryCollectionPage(denomID, nil, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page.NFTs, 2)
+	require.Equal(s.T(), uint64(3), page.Total)
+	require.NotEmpty(s.T(), page.NextKey)
+
+	page, err = s.NFT.QueryCollectionPage(denomID, page.NextKey, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page.NFTs, 1)
+	require.Empty(s.T(), page.NextKey)
+
+	denoms, err := s.NFT.QueryDenomsPage(nil, 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), denoms.Denoms, 1)
+
+	// the nfts of the owner across all the denoms
+	owned, err := s.NFT.QueryOwner(owner, "")
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), owned.IDCs)
+
+	var iterated []string
+	it := s.NFT.IterateOwnedNFTs(owner, 2)
+	for it.Next() {
+		if it.NFT().DenomID == denomID {
+			iterated = append(iterated, it.NFT().TokenID)
+		}
+	}
+	require.NoError(s.T(), it.Err())
+	require.ElementsMatch(s.T(), tokenIDs, iterated)
+
+	_, err = s.NFT.BurnNFT(nft.BurnNFTRequest{Denom: denomID, ID: tokenIDs[0]}, baseTx)
+	require.NoError(s.T(), err)
+
+	idx, err := s.NFT.RebuildOwnership(denomID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, idx.Len())
+	_, ok := idx.Owner(denomID, tokenIDs[0])
+	require.False(s.T(), ok)
+	o, ok := idx.Owner(denomID, tokenIDs[1])
+	require.True(s.T(), ok)
+	require.Equal(s.T(), owner, o)
+	require.ElementsMatch(s.T(), tokenIDs[1:], idx.NFTsOf(owner)[0].TokenIDs)
+}
+
+func (s IntegrationTestSuite) TestNFTSchema() {
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "test",
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+
+	type metadata struct {
+		Name  string `json:"name"`
+		Level int    `json:"level"`
+	}
+
+	denomID := strings.ToLower(s.RandStringOfLength(4))
+	_, err := s.NFT.IssueDenom(nft.IssueDenomRequest{
+		ID:     denomID,
+		Name:   strings.ToLower(s.RandStringOfLength(4)),
+		Schema: `{"type":"object","properties":{"name":{"type":"string"},"level":{"type":"integer","minimum":1}},"required":["name"]}`,
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	mintReq := nft.MintNFTRequest{
+		Denom: denomID,
+		ID:    strings.ToLower(s.RandStringOfLength(7)),
+		Name:  strings.ToLower(s.RandStringOfLength(7)),
+		URI:   fmt.Sprintf("https://%s", s.RandStringOfLength(10)),
+		Data:  `{"level":0}`,
+	}
+	_, err = s.NFT.MintNFT(mintReq, baseTx)
+	require.True(s.T(), errors.Is(err, nft.ErrDataSchemaMismatch))
+
+	data, e := nft.MarshalData(metadata{Name: "sword", Level: 3})
+	require.NoError(s.T(), e)
+	mintReq.Data = data
+	_, err = s.NFT.MintNFT(mintReq, baseTx)
+	require.NoError(s.T(), err)
+
+	token, err := s.NFT.QueryNFT(denomID, mintReq.ID)
+	require.NoError(s.T(), err)
+	var m metadata
+	require.NoError(s.T(), token.UnmarshalData(&m))
+	require.Equal(s.T(), metadata{Name: "sword", Level: 3}, m)
+
+	_, err = s.NFT.EditNFT(nft.EditNFTRequest{
+		Denom: denomID,
+		ID:    mintReq.ID,
+		Data:  `{"name":1}`,
+	}, baseTx)
+	require.True(s.T(), errors.Is(err, nft.ErrDataSchemaMismatch))
+
+	// the data: uris are decoded without fetching
+	resolver := nft.URIResolver{}
+	require.NoError(s.T(), resolver.FetchJSON(context.Background(), "data:application/json;base64,eyJuYW1lIjoic2hpZWxkIn0=", &m))
+	require.Equal(s.T(), "shield", m.Name)
+
+	url, e := resolver.Resolve("ipfs://bafybeigdyrzt/metadata.json")
+	require.NoError(s.T(), e)
+	require.Equal(s.T(), "https://ipfs.io/ipfs/bafybeigdyrzt/metadata.json", url)
+}
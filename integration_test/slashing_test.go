@@ -0,0 +1,57 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestSlashing() {
+	cases := []SubTest{
+		{
+			"TestQuerySigningInfos",
+			querySigningInfos,
+		},
+		{
+			"TestUnjail",
+			unjail,
+		},
+	}
+
+	for _, t := range cases {
+		s.Run(t.testName, func() {
+			t.testCase(s)
+		})
+	}
+}
+
+func querySigningInfos(s IntegrationTestSuite) {
+	params, err := s.Slashing.QueryParams()
+	require.NoError(s.T(), err)
+	require.True(s.T(), params.SignedBlocksWindow > 0)
+	require.True(s.T(), params.MinSignedPerWindow.IsPositive())
+
+	infos, err := s.Slashing.QuerySigningInfos(1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), infos.SigningInfos)
+	require.True(s.T(), infos.Total > 0)
+
+	info, err := s.Slashing.QuerySigningInfo(infos.SigningInfos[0].Address)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), infos.SigningInfos[0].Address, info.Address)
+	require.False(s.T(), info.Tombstoned)
+}
+
+func unjail(s IntegrationTestSuite) {
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "test",
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+
+	// the validator of the test chain is not jailed
+	_, err := s.Slashing.Unjail(baseTx)
+	require.Error(s.T(), err)
+}
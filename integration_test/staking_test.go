@@ -29,6 +29,10 @@ func (s IntegrationTestSuite) TestStaking() {
 			"TestQueryParams",
 			queryParams,
 		},
+		{
+			"TestPortfolio",
+			testPortfolio,
+		},
 	}
 
 	for _, t := range cases {
@@ -283,6 +287,47 @@ func queryParams(s IntegrationTestSuite) {
 	require.Equal(s.T(), MaxEntries, res.MaxEntries)
 }
 
+func testPortfolio(s IntegrationTestSuite) {
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "test",
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+
+	validatorAddr := s.curValAddr()
+	amount, _ := sdk.ParseDecCoin("100iris")
+	_, err := s.Staking.Delegate(staking.DelegateRequest{
+		ValidatorAddr: validatorAddr,
+		Amount:        amount,
+	}, baseTx)
+	require.NoError(s.T(), err)
+
+	portfolio, err := s.Staking.Portfolio(s.Account().Address.String())
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), portfolio.Delegations)
+
+	var found bool
+	for _, d := range portfolio.Delegations {
+		if d.Validator.OperatorAddress == validatorAddr {
+			found = true
+			require.True(s.T(), d.Tokens.Amount.IsPositive())
+			require.NotEmpty(s.T(), d.Validator.Moniker)
+		}
+	}
+	require.True(s.T(), found)
+	require.True(s.T(), portfolio.Totals.Delegated.Min.IsAllPositive())
+	require.Equal(s.T(), "iris", portfolio.Totals.Delegated.Main[0].Denom)
+
+	rewards, err := s.Distribution.QueryRewards(s.Account().Address.String())
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), rewards.Rewards)
+
+	_, err = s.Distribution.WithdrawRewards([]string{validatorAddr}, baseTx)
+	require.NoError(s.T(), err)
+}
+
 func (s IntegrationTestSuite) curValAddr() string {
 	// queries all validators that match the given status.
 	validatorsResp, err := s.Staking.QueryValidators("", 1, 10)
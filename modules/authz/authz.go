@@ -0,0 +1,108 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+)
+
+type authzClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return authzClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (ac authzClient) Name() string {
+	return ModuleName
+}
+
+func (ac authzClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (ac authzClient) Grant(request GrantRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	granter, err := ac.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(request.Grantee)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg, e := NewMsgGrant(granter, grantee, NewGenericAuthorization(request.MsgTypeURL), request.Expiration)
+	if e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+	return ac.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (ac authzClient) Revoke(request RevokeRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	granter, err := ac.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    request.Grantee,
+		MsgTypeUrl: request.MsgTypeURL,
+	}
+	return ac.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// Exec executes the msgs signed by their granters, baseTx.From must be granted for every msg
+func (ac authzClient) Exec(msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	grantee, err := ac.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg, e := NewMsgExec(grantee, msgs)
+	if e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+	return ac.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// QueryGrants returns the grants of the granter to the grantee, if msgTypeURL is empty will return the grants of all the msg types
+func (ac authzClient) QueryGrants(granter, grantee, msgTypeURL string) ([]QueryGrantResp, sdk.Error) {
+	conn, err := ac.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Grants(
+		context.Background(),
+		&QueryGrantsRequest{
+			Granter:    granter,
+			Grantee:    grantee,
+			MsgTypeUrl: msgTypeURL,
+			Pagination: &query.PageRequest{
+				Offset:     0,
+				Limit:      100,
+				CountTotal: true,
+			},
+		})
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	for _, grant := range res.Grants {
+		if err := grant.UnpackInterfaces(ac.Marshaler); err != nil {
+			return nil, sdk.Wrap(err)
+		}
+	}
+	return Grants(res.Grants).Convert().([]QueryGrantResp), nil
+}
@@ -0,0 +1,11 @@
+package authz
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the authz module of the chain, use errors.Is to match them
+var (
+	ErrNoAuthorizationFound  = sdk.Register(ModuleName, 2, "authorization not found")
+	ErrInvalidExpirationTime = sdk.Register(ModuleName, 3, "expiration time of authorization should be more than current time")
+)
@@ -0,0 +1,36 @@
+package authz
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Authz module api for user, the module is available on the chains running the authz module of cosmos-sdk v0.43+
+type Client interface {
+	sdk.Module
+
+	Grant(request GrantRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	Revoke(request RevokeRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	Exec(msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryGrants(granter, grantee, msgTypeURL string) ([]QueryGrantResp, sdk.Error)
+}
+
+// GrantRequest grants the grantee to execute the msgs of the type url on behalf of baseTx.From until the expiration
+type GrantRequest struct {
+	Grantee    string    `json:"grantee"`
+	MsgTypeURL string    `json:"msg_type_url"`
+	Expiration time.Time `json:"expiration"`
+}
+
+type RevokeRequest struct {
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msg_type_url"`
+}
+
+type QueryGrantResp struct {
+	MsgTypeURL    string        `json:"msg_type_url"`
+	Authorization Authorization `json:"authorization"`
+	Expiration    time.Time     `json:"expiration"`
+}
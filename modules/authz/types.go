@@ -0,0 +1,298 @@
+package authz
+
+import (
+	fmt "fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "authz"
+)
+
+var (
+	_ sdk.Msg = &MsgGrant{}
+	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRevoke{}
+
+	_ Authorization = &GenericAuthorization{}
+
+	_ types.UnpackInterfacesMessage = MsgGrant{}
+	_ types.UnpackInterfacesMessage = MsgExec{}
+	_ types.UnpackInterfacesMessage = Grant{}
+)
+
+// Authorization represents the permission granted by a granter to a grantee to execute a type of msg
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type url of the msg the authorization permits to execute
+	MsgTypeURL() string
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+}
+
+// MsgTypeURL returns the type url of the msg, e.g. "/cosmos.gov.v1beta1.MsgVote"
+func MsgTypeURL(msg proto.Message) string {
+	return "/" + proto.MessageName(msg)
+}
+
+// NewGenericAuthorization creates an authorization permitting to execute any msg of the type url
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgTypeURL,
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// ValidateBasic implements Authorization
+func (a GenericAuthorization) ValidateBasic() error {
+	if len(a.Msg) == 0 {
+		return sdk.Wrapf("missing msg type url")
+	}
+	return nil
+}
+
+// NewGrant creates a grant of the authorization expiring at expiration
+func NewGrant(authorization Authorization, expiration time.Time) (Grant, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the cached authorization of the grant, nil if it is not unpacked
+func (g Grant) GetAuthorization() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+	authorization, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// ValidateBasic checks the authorization and the expiration of the grant
+func (g Grant) ValidateBasic() error {
+	authorization := g.GetAuthorization()
+	if authorization == nil {
+		return sdk.Wrapf("missing authorization")
+	}
+	if g.Expiration.IsZero() {
+		return sdk.Wrapf("missing expiration")
+	}
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func (g Grant) Convert() interface{} {
+	var msgTypeURL string
+	authorization := g.GetAuthorization()
+	if authorization != nil {
+		msgTypeURL = authorization.MsgTypeURL()
+	}
+	return QueryGrantResp{
+		MsgTypeURL:    msgTypeURL,
+		Authorization: authorization,
+		Expiration:    g.Expiration,
+	}
+}
+
+type Grants []*Grant
+
+func (gs Grants) Convert() interface{} {
+	var res []QueryGrantResp
+	for _, g := range gs {
+		res = append(res, g.Convert().(QueryGrantResp))
+	}
+	return res
+}
+
+// NewMsgGrant creates a MsgGrant of the authorization from the granter to the grantee
+//
+//nolint:interfacer
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) (*MsgGrant, error) {
+	grant, err := NewGrant(authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   grant,
+	}, nil
+}
+
+func (msg MsgGrant) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgGrant) Type() string { return "grant" }
+
+// ValidateBasic implements Msg
+func (msg MsgGrant) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdk.Wrapf("invalid granter address: %s", msg.Granter)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdk.Wrapf("invalid grantee address: %s", msg.Grantee)
+	}
+	if granter.Equals(grantee) {
+		return sdk.Wrapf("granter and grantee cannot be same")
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgGrant) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgExec creates a MsgExec executing the msgs by the grantee on behalf of their signers
+//
+//nolint:interfacer
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    anys,
+	}, nil
+}
+
+// GetMessages returns the cached msgs of the MsgExec
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("message contains %T which is not a sdk.Msg", any.GetCachedValue())
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+func (msg MsgExec) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgExec) Type() string { return "exec" }
+
+// ValidateBasic implements Msg
+func (msg MsgExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdk.Wrapf("invalid grantee address: %s", msg.Grantee)
+	}
+	if len(msg.Msgs) == 0 {
+		return sdk.Wrapf("missing msgs to execute")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgExec) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, _ := sdk.AccAddressFromBech32(msg.Grantee)
+	return []sdk.AccAddress{grantee}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgRevoke) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRevoke) Type() string { return "revoke" }
+
+// ValidateBasic implements Msg
+func (msg MsgRevoke) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdk.Wrapf("invalid granter address: %s", msg.Granter)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdk.Wrapf("invalid grantee address: %s", msg.Grantee)
+	}
+	if granter.Equals(grantee) {
+		return sdk.Wrapf("granter and grantee cannot be same")
+	}
+	if len(msg.MsgTypeUrl) == 0 {
+		return sdk.Wrapf("missing msg type url")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRevoke) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
@@ -0,0 +1,13 @@
+package bank
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the bank module of irishub, use errors.Is to match them
+var (
+	ErrNoInputs            = sdk.Register(ModuleName, 2, "no inputs to send transaction")
+	ErrNoOutputs           = sdk.Register(ModuleName, 3, "no outputs to send transaction")
+	ErrInputOutputMismatch = sdk.Register(ModuleName, 4, "sum inputs != sum outputs")
+	ErrSendDisabled        = sdk.Register(ModuleName, 5, "send transactions are disabled")
+)
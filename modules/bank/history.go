@@ -0,0 +1,57 @@
+package bank
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	eventTypeTransfer     = "transfer"
+	attributeKeyRecipient = "recipient"
+)
+
+var _ sdk.HistoryModule = bankClient{}
+
+// SendActivity is the activity of a MsgSend
+type SendActivity struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Amount sdk.Coins `json:"amount"`
+}
+
+func (a SendActivity) Module() string { return ModuleName }
+func (a SendActivity) Action() string { return TypeMsgSend }
+
+// MultiSendActivity is the activity of a MsgMultiSend
+type MultiSendActivity struct {
+	Inputs  []Input  `json:"inputs"`
+	Outputs []Output `json:"outputs"`
+}
+
+func (a MultiSendActivity) Module() string { return ModuleName }
+func (a MultiSendActivity) Action() string { return TypeMsgMultiSend }
+
+// HistoryQueries returns the query of the txs transferring tokens to the address
+func (b bankClient) HistoryQueries(address string) []*sdk.EventQueryBuilder {
+	return []*sdk.EventQueryBuilder{
+		sdk.NewEventQueryBuilder().AddCondition(
+			sdk.NewCond(eventTypeTransfer, attributeKeyRecipient).EQ(sdk.EventValue(address)),
+		),
+	}
+}
+
+func (b bankClient) ParseActivity(msg sdk.Msg) (sdk.Activity, bool) {
+	switch msg := msg.(type) {
+	case *MsgSend:
+		return SendActivity{
+			From:   msg.FromAddress,
+			To:     msg.ToAddress,
+			Amount: msg.Amount,
+		}, true
+	case *MsgMultiSend:
+		return MultiSendActivity{
+			Inputs:  msg.Inputs,
+			Outputs: msg.Outputs,
+		}, true
+	}
+	return nil, false
+}
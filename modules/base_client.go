@@ -11,25 +11,30 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/tx"
-	"github.com/irisnet/irishub-sdk-go/utils"
 	"github.com/irisnet/irishub-sdk-go/utils/cache"
 	sdklog "github.com/irisnet/irishub-sdk-go/utils/log"
 )
 
 const (
-	concurrency       = 16
-	cacheCapacity     = 100
-	cacheExpirePeriod = 1 * time.Minute
-	tryThreshold      = 3
-	maxBatch          = 100
+	concurrency        = 16
+	cacheCapacity      = 100
+	blockCacheCapacity = 1000
+	cacheExpirePeriod  = 1 * time.Minute
+	maxBatch           = 100
+	// interval between the lookups of a transaction submitted already in the Commit mode
+	lookupInterval = 1 * time.Second
 )
 
 type baseClient struct {
@@ -40,6 +45,10 @@ type baseClient struct {
 	cfg            *sdk.ClientConfig
 	encodingConfig sdk.EncodingConfig
 	l              *locker
+	tracer         trace.Tracer
+	sequences      *sequenceManager
+	txDecoder      sdk.TxDecoder
+	blockTimeCache cache.Cache
 
 	accountQuery
 	tokenQuery
@@ -55,13 +64,23 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 		})
 	}
 
+	tracer := cfg.TracerProvider.Tracer(tracerName)
+	grpcClient := NewGRPCClient(cfg.GRPCAddr, grpc.WithChainUnaryInterceptor(
+		tracingInterceptor(tracer),
+		retryInterceptor(cfg.RetryPolicy),
+		metricsInterceptor(cfg.Metrics),
+	))
+	txDecoder := newTxDecoder(cfg, encodingConfig, grpcClient, logger)
 	base := baseClient{
-		TmClient:       NewRPCClient(cfg.NodeURI, encodingConfig.Amino, encodingConfig.TxConfig.TxDecoder(), logger, cfg.Timeout),
-		GRPCClient:     NewGRPCClient(cfg.GRPCAddr),
+		TmClient:       NewRPCClient(cfg.NodeURI, encodingConfig.Amino, txDecoder, logger, cfg.Timeout, cfg.Metrics, tracer),
+		GRPCClient:     grpcClient,
+		txDecoder:      txDecoder,
+		blockTimeCache: cache.NewCache(blockCacheCapacity, true),
 		logger:         logger,
 		cfg:            &cfg,
 		encodingConfig: encodingConfig,
 		l:              NewLocker(concurrency),
+		tracer:         tracer,
 	}
 
 	base.KeyManager = keyManager{
@@ -79,6 +98,7 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 		km:         base.KeyManager,
 		expiration: cacheExpirePeriod,
 	}
+	base.sequences = newSequenceManager(base.accountQuery.queryAccount, cfg.Cached, cacheExpirePeriod, cfg.Metrics, base.Logger())
 
 	base.tokenQuery = tokenQuery{
 		q:          base,
@@ -86,6 +106,7 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 		cdc:        encodingConfig.Marshaler,
 		Logger:     base.Logger(),
 		Cache:      c,
+		metrics:    cfg.Metrics,
 	}
 
 	return &base
@@ -104,28 +125,28 @@ func (base *baseClient) Marshaler() codec.Marshaler {
 	return base.encodingConfig.Marshaler
 }
 
-func (base *baseClient) BuildAndSend(msg []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
-	txByte, ctx, err := base.buildTx(msg, baseTx)
-	if err != nil {
-		return sdk.ResultTx{}, err
-	}
+func (base *baseClient) BuildAndSend(msg []sdk.Msg, baseTx sdk.BaseTx) (res sdk.ResultTx, err sdk.Error) {
+	return base.BuildAndSendWithContext(context.Background(), msg, baseTx)
+}
 
-	if err := base.ValidateTxSize(len(txByte), msg); err != nil {
-		return sdk.ResultTx{}, err
-	}
-	return base.broadcastTx(txByte, ctx.Mode(), baseTx.Simulate)
+// BuildAndSendWithContext is BuildAndSend stopping the broadcasts and the retries when ctx is done
+func (base *baseClient) BuildAndSendWithContext(ctx context.Context, msg []sdk.Msg, baseTx sdk.BaseTx) (res sdk.ResultTx, err sdk.Error) {
+	ctx, span := base.tracer.Start(ctx, "BuildAndSend")
+	defer func() { endSpan(span, err) }()
+
+	return base.signAndSend(ctx, msg, baseTx.Simulate, true, func() ([]byte, *clienttx.Factory, sdk.Error) {
+		return base.buildTx(ctx, msg, baseTx)
+	})
 }
 
-func (base *baseClient) BuildAndSendWithAccount(addr string, accountNumber, sequence uint64, msg []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
-	txByte, ctx, err := base.buildTxWithAccount(addr, accountNumber, sequence, msg, baseTx)
-	if err != nil {
-		return sdk.ResultTx{}, err
-	}
+func (base *baseClient) BuildAndSendWithAccount(addr string, accountNumber, sequence uint64, msg []sdk.Msg, baseTx sdk.BaseTx) (res sdk.ResultTx, err sdk.Error) {
+	ctx, span := base.tracer.Start(context.Background(), "BuildAndSendWithAccount")
+	defer func() { endSpan(span, err) }()
 
-	if err := base.ValidateTxSize(len(txByte), msg); err != nil {
-		return sdk.ResultTx{}, err
-	}
-	return base.broadcastTx(txByte, ctx.Mode(), baseTx.Simulate)
+	// the sequence is given by the caller, a transaction rejected for a sequence mismatch is not signed again
+	return base.signAndSend(ctx, msg, baseTx.Simulate, false, func() ([]byte, *clienttx.Factory, sdk.Error) {
+		return base.buildTxWithAccount(ctx, addr, accountNumber, sequence, msg, baseTx)
+	})
 }
 
 func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.ResultTx, err sdk.Error) {
@@ -133,6 +154,9 @@ func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.Re
 		return rs, sdk.Wrapf("must have at least one message in list")
 	}
 
+	goCtx, span := base.tracer.Start(context.Background(), "SendBatch")
+	defer func() { endSpan(span, err) }()
+
 	defer sdk.CatchPanic(func(errMsg string) {
 		base.Logger().Error("broadcast msg failed", "errMsg", errMsg)
 	})
@@ -149,51 +173,170 @@ func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.Re
 	defer base.l.Unlock(baseTx.From)
 
 	batch := maxBatch
-	var tryCnt = 0
-
-resize:
-	for i, ms := range utils.SubArray(batch, msgs) {
-		mss := ms.(sdk.Msgs)
-
-	retry:
-		txByte, ctx, err := base.buildTx(mss, baseTx)
-		if err != nil {
-			return rs, err
+	for len(msgs) > 0 {
+		if batch > len(msgs) {
+			batch = len(msgs)
 		}
+		mss := msgs[:batch]
 
-		if err := base.ValidateTxSize(len(txByte), mss); err != nil {
+		res, err := base.signAndSend(goCtx, mss, baseTx.Simulate, true, func() ([]byte, *clienttx.Factory, sdk.Error) {
+			return base.buildTx(goCtx, mss, baseTx)
+		})
+		if errors.Is(err, sdk.ErrTxTooLarge) && batch > 1 {
 			base.Logger().Debug("tx is too large", "msgsLength", batch, "errMsg", err.Error())
-
-			// filter out transactions that have been sent
-			msgs = msgs[i*batch:]
 			// reset the maximum number of msg in each transaction
 			batch = batch / 2
-			_ = base.removeCache(ctx.Address())
-			goto resize
+			continue
 		}
-
-		res, err := base.broadcastTx(txByte, ctx.Mode(), baseTx.Simulate)
 		if err != nil {
-			if sdk.Code(err.Code()) == sdk.InvalidSequence {
-				base.Logger().Debug("wrong sequence,retrying ...", "address", ctx.Address(), "tryCnt", tryCnt)
-
-				_ = base.removeCache(ctx.Address())
-				if tryCnt++; tryCnt >= tryThreshold {
-					return rs, err
-				}
-				goto retry
-			}
-
 			base.Logger().Error("broadcast transaction failed", "errMsg", err.Error())
 			return rs, err
 		}
 		rs = append(rs, res)
+		// filter out transactions that have been sent
+		msgs = msgs[batch:]
 
 		base.Logger().Info("broadcast transaction success", "txHash", res.Hash, "height", res.Height)
 	}
 	return rs, nil
 }
 
+// signAndSend signs a transaction with sign and broadcasts it. The transient errors are retried
+// according to the retry policy, the attempts of all the signings are counted together.
+//
+// The signed bytes are re-broadcast as is. The transaction is only signed again if its sequence is
+// managed by the client, and the node rejected it for a sequence mismatch while no earlier attempt
+// may have reached the node. A transaction already in the mempool cache, or rejected for a sequence
+// mismatch after an attempt with an unknown outcome (e.g. a timed out broadcast), has been submitted
+// already and is looked up by hash instead, signing it again could execute it twice.
+func (base *baseClient) signAndSend(
+	ctx context.Context,
+	msgs []sdk.Msg,
+	simulate, managed bool,
+	sign func() ([]byte, *clienttx.Factory, sdk.Error),
+) (res sdk.ResultTx, err sdk.Error) {
+	var (
+		txBytes []byte
+		builder *clienttx.Factory
+		// whether an attempt of txBytes may have reached the node
+		unclear bool
+	)
+
+	span := trace.SpanFromContext(ctx)
+	finish := func(res sdk.ResultTx, err sdk.Error, attempt int) (sdk.ResultTx, sdk.Error) {
+		span.SetAttributes(attrAttempts.Int(attempt))
+		if managed {
+			base.settleSequence(builder, simulate, unclear, res, err)
+		}
+		return res, err
+	}
+
+	policy := base.cfg.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		if txBytes == nil {
+			if txBytes, builder, err = sign(); err != nil {
+				return sdk.ResultTx{}, err
+			}
+			if err = base.ValidateTxSize(len(txBytes), msgs); err == nil {
+				err = base.recordTx(builder, txBytes, simulate)
+			}
+			if err != nil {
+				// the transaction is not broadcast, give the sequence back
+				if managed {
+					base.sequences.release(builder.Address(), builder.Sequence())
+				}
+				return sdk.ResultTx{}, err
+			}
+			unclear = false
+		}
+
+		res, err = base.sendTx(ctx, builder, txBytes, simulate)
+		if simulate {
+			return finish(res, err, attempt)
+		}
+
+		if errors.Is(err, sdk.ErrTxInMempoolCache) || (unclear && errors.Is(err, sdk.ErrInvalidSequence)) {
+			base.Logger().Info("transaction submitted already, looking it up ...", "errMsg", err.Error())
+			unclear = true
+			res, err = base.lookupTx(ctx, builder.Mode(), txBytes)
+			base.updateOutbox(res.Hash, res, err)
+			return finish(res, err, attempt)
+		}
+
+		if err != nil && len(err.TxHash()) == 0 && res.Height == 0 {
+			// whether the transaction reached the node is unknown, e.g. the broadcast timed out
+			unclear = true
+		}
+
+		mismatch := errors.Is(err, sdk.ErrInvalidSequence)
+		if (mismatch && !managed) || !policy.ShouldRetry(attempt, err) {
+			return finish(res, err, attempt)
+		}
+
+		if e := policy.Wait(ctx, attempt); e != nil {
+			_, _ = finish(res, err, attempt)
+			return res, sdk.Wrap(e)
+		}
+
+		if mismatch {
+			// rejected before reaching the mempool, sign it again with the sequence expected by the node
+			base.Logger().Debug("wrong sequence,retrying ...", "address", builder.Address(), "attempt", attempt)
+			base.cfg.Metrics.SequenceRetry()
+			base.sequences.mismatch(builder.Address(), builder.Sequence(), err)
+			// the rejected transaction can never be executed, the one signed again replaces it in the outbox
+			base.dropOutbox(txBytes)
+			txBytes = nil
+			continue
+		}
+		base.Logger().Debug("broadcast transaction failed, retrying ...", "attempt", attempt, "errMsg", err.Error())
+	}
+}
+
+// lookupTx returns the result of the transaction submitted by an earlier broadcast. A transaction not
+// found in a block is still in the mempool, its hash is returned at once in the Sync and Async modes,
+// in the Commit mode it is waited for until the broadcast timeout, after which ErrTxInMempoolCache is
+// returned.
+func (base *baseClient) lookupTx(ctx context.Context, mode sdk.BroadcastMode, txBytes []byte) (sdk.ResultTx, sdk.Error) {
+	hash := tmhash.Sum(txBytes)
+	deadline := time.Now().Add(time.Duration(base.cfg.Timeout) * time.Second)
+	for {
+		res, err, found := base.findTx(ctx, hash)
+		if found || mode != sdk.Commit {
+			return res, err
+		}
+		if time.Now().After(deadline) {
+			return res, sdk.GetTxError(res.Hash, sdk.RootCodespace, uint32(sdk.TxInMempoolCache),
+				fmt.Sprintf("transaction is in the mempool but not committed in %d seconds", base.cfg.Timeout))
+		}
+
+		select {
+		case <-ctx.Done():
+			return res, sdk.Wrap(ctx.Err())
+		case <-time.After(lookupInterval):
+		}
+	}
+}
+
+// findTx returns the result of the transaction in a block, found is false if the transaction is not indexed
+// by the node, in which case only the hash of the result is set. The error is that of a transaction failed
+// in the block.
+func (base *baseClient) findTx(ctx context.Context, hash []byte) (res sdk.ResultTx, err sdk.Error, found bool) {
+	res.Hash = sdk.HexBytes(hash).String()
+	resTx, e := base.Tx(ctx, hash, false)
+	if e != nil {
+		return res, nil, false
+	}
+
+	res.GasWanted = resTx.TxResult.GasWanted
+	res.GasUsed = resTx.TxResult.GasUsed
+	res.Events = sdk.StringifyEvents(resTx.TxResult.Events)
+	res.Height = resTx.Height
+	if resTx.TxResult.Code != 0 {
+		err = sdk.GetTxError(res.Hash, resTx.TxResult.Codespace, resTx.TxResult.Code, resTx.TxResult.Log)
+	}
+	return res, err, true
+}
+
 func (base baseClient) QueryWithResponse(path string, data interface{}, result sdk.Response) error {
 	res, err := base.Query(path, data)
 	if err != nil {
@@ -221,7 +364,7 @@ func (base baseClient) Query(path string, data interface{}) ([]byte, error) {
 		// Height: cliCtx.Height,
 		Prove: false,
 	}
-	result, err := base.ABCIQueryWithOptions(context.Background(), path, bz, opts)
+	result, err := base.abciQuery(context.Background(), path, bz, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +384,7 @@ func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int
 		Height: height,
 	}
 
-	result, err := base.ABCIQueryWithOptions(context.Background(), path, key, opts)
+	result, err := base.abciQuery(context.Background(), path, key, opts)
 	if err != nil {
 		return res, err
 	}
@@ -253,8 +396,39 @@ func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int
 	return resp, nil
 }
 
-func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
+// abciQuery performs an abci query, retrying on transient errors according to the retry policy
+func (base baseClient) abciQuery(ctx context.Context, path string, data sdk.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	var result *ctypes.ResultABCIQuery
+	err := base.retryQuery(ctx, "abci query "+path, func() (err error) {
+		result, err = base.ABCIQueryWithOptions(ctx, path, data, opts)
+		return err
+	})
+	return result, err
+}
+
+// retryQuery calls query until it succeeds, fails with an error which is not retryable
+// or the attempts of the retry policy are exhausted
+func (base baseClient) retryQuery(ctx context.Context, name string, query func() error) error {
+	policy := base.cfg.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		err := query()
+		if !policy.ShouldRetry(attempt, err) {
+			return err
+		}
+
+		base.Logger().Debug(name+" failed, retrying ...", "attempt", attempt, "errMsg", err.Error())
+		if e := policy.Wait(ctx, attempt); e != nil {
+			return err
+		}
+	}
+}
+
+func (base *baseClient) prepare(ctx context.Context, baseTx sdk.BaseTx) (_ *clienttx.Factory, err error) {
+	ctx, span := base.tracer.Start(ctx, "prepare")
+	defer func() { endSpan(span, err) }()
+
 	factory := clienttx.NewFactory().
+		WithMetrics(base.cfg.Metrics).
 		WithChainID(base.cfg.ChainID).
 		WithKeyManager(base.KeyManager).
 		WithMode(base.cfg.Mode).
@@ -267,24 +441,18 @@ func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	if err != nil {
 		return nil, err
 	}
-	factory.WithAddress(addr.String())
-
-	account, err := base.QueryAndRefreshAccount(addr.String())
-	if err != nil {
-		return nil, err
-	}
-	factory.WithAccountNumber(account.AccountNumber).
-		WithSequence(account.Sequence).
+	factory.WithAddress(addr.String()).
 		WithPassword(baseTx.Password)
+	span.SetAttributes(attrAddress.String(addr.String()))
 
 	if !baseTx.Fee.Empty() && baseTx.Fee.IsValid() {
-		fees, err := base.ToMinCoin(baseTx.Fee...)
+		fees, err := base.toMinCoin(ctx, baseTx.Fee...)
 		if err != nil {
 			return nil, err
 		}
 		factory.WithFee(fees)
 	} else {
-		fees, err := base.ToMinCoin(base.cfg.Fee...)
+		fees, err := base.toMinCoin(ctx, base.cfg.Fee...)
 		if err != nil {
 			panic(err)
 		}
@@ -302,12 +470,21 @@ func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	if len(baseTx.Memo) > 0 {
 		factory.WithMemo(baseTx.Memo)
 	}
+
+	// acquire the sequence at last, so that it is always settled by the caller
+	accountNumber, sequence, err := base.sequences.acquire(ctx, addr.String())
+	if err != nil {
+		return nil, err
+	}
+	factory.WithAccountNumber(accountNumber).
+		WithSequence(sequence)
 	return factory, nil
 }
 
 // TODO
-func (base *baseClient) prepareTemp(addr string, accountNumber, sequence uint64, baseTx sdk.BaseTx) (*clienttx.Factory, error) {
+func (base *baseClient) prepareTemp(ctx context.Context, addr string, accountNumber, sequence uint64, baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	factory := clienttx.NewFactory().
+		WithMetrics(base.cfg.Metrics).
 		WithChainID(base.cfg.ChainID).
 		WithKeyManager(base.KeyManager).
 		WithMode(base.cfg.Mode).
@@ -322,13 +499,13 @@ func (base *baseClient) prepareTemp(addr string, accountNumber, sequence uint64,
 		WithPassword(baseTx.Password)
 
 	if !baseTx.Fee.Empty() && baseTx.Fee.IsValid() {
-		fees, err := base.ToMinCoin(baseTx.Fee...)
+		fees, err := base.toMinCoin(ctx, baseTx.Fee...)
 		if err != nil {
 			return nil, err
 		}
 		factory.WithFee(fees)
 	} else {
-		fees, err := base.ToMinCoin(base.cfg.Fee...)
+		fees, err := base.toMinCoin(ctx, base.cfg.Fee...)
 		if err != nil {
 			panic(err)
 		}
@@ -349,6 +526,27 @@ func (base *baseClient) prepareTemp(addr string, accountNumber, sequence uint64,
 	return factory, nil
 }
 
+// settleSequence reports the final outcome of the transaction built by builder to the sequence manager,
+// unclear tells whether the transaction may have reached the node
+func (base *baseClient) settleSequence(builder *clienttx.Factory, simulate, unclear bool, res sdk.ResultTx, err sdk.Error) {
+	address, sequence := builder.Address(), builder.Sequence()
+	switch {
+	case simulate:
+		base.sequences.release(address, sequence)
+	case err == nil, unclear:
+		// the transaction may have been accepted by the node
+		base.sequences.confirm(address, sequence)
+	case errors.Is(err, sdk.ErrInvalidSequence):
+		base.sequences.mismatch(address, sequence, err)
+	case res.Height == 0:
+		// rejected by CheckTx
+		base.sequences.release(address, sequence)
+	default:
+		// failed in the block
+		base.sequences.confirm(address, sequence)
+	}
+}
+
 func (base *baseClient) ValidateTxSize(txSize int, msgs []sdk.Msg) sdk.Error {
 	//var isServiceTx bool
 	//for _, msg := range msgs {
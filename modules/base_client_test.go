@@ -0,0 +1,214 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// retryAll retries every error without backoff
+var retryAll = sdk.RetryPolicy{
+	MaxAttempts: 3,
+	Retryable:   func(err error) bool { return true },
+}
+
+// broadcastRecorder records the txs broadcast to the node and answers them in turn
+type broadcastRecorder struct {
+	mu  sync.Mutex
+	txs []tmtypes.Tx
+}
+
+func (r *broadcastRecorder) handler(t *testing.T, answers ...func(tx tmtypes.Tx) (interface{}, error)) func(params json.RawMessage) (interface{}, error) {
+	return func(params json.RawMessage) (interface{}, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		tx := tmtypes.Tx(decodeTxParam(t, params))
+		r.txs = append(r.txs, tx)
+		answer := answers[len(answers)-1]
+		if len(r.txs) <= len(answers) {
+			answer = answers[len(r.txs)-1]
+		}
+		return answer(tx)
+	}
+}
+
+func timeout(tmtypes.Tx) (interface{}, error) {
+	return nil, errors.New("timed out waiting for tx to be included in a block")
+}
+
+func accept(tx tmtypes.Tx) (interface{}, error) {
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func drop(tmtypes.Tx) (interface{}, error) {
+	return nil, errDropConnection
+}
+
+func reject(code uint32, log string) func(tx tmtypes.Tx) (interface{}, error) {
+	return func(tx tmtypes.Tx) (interface{}, error) {
+		return &ctypes.ResultBroadcastTx{Code: code, Codespace: sdk.RootCodespace, Log: log, Hash: tx.Hash()}, nil
+	}
+}
+
+func TestSendRetriesTheSameBytes(t *testing.T) {
+	node := newTestNode(t)
+	base := newTestClient(t, node, sdk.RetryPolicyOption(retryAll))
+
+	var recorder broadcastRecorder
+	node.handle("broadcast_tx_sync", recorder.handler(t, timeout, accept))
+
+	msgs, baseTx := testSend(t, base)
+	res, err := base.BuildAndSend(msgs, baseTx)
+	require.NoError(t, err)
+	require.Len(t, recorder.txs, 2)
+	require.Equal(t, recorder.txs[0], recorder.txs[1])
+	require.Equal(t, sdk.HexBytes(recorder.txs[0].Hash()).String(), res.Hash)
+}
+
+func TestDefaultPolicyRetriesNetworkErrors(t *testing.T) {
+	node := newTestNode(t)
+	// the errors are classified by sdk.IsRetryable
+	base := newTestClient(t, node, sdk.RetryPolicyOption(sdk.RetryPolicy{MaxAttempts: 3}))
+
+	var recorder broadcastRecorder
+	node.handle("broadcast_tx_sync", recorder.handler(t, drop, accept))
+	msgs, baseTx := testSend(t, base)
+	_, err := base.BuildAndSend(msgs, baseTx)
+	require.NoError(t, err)
+	require.Len(t, recorder.txs, 2)
+	require.Equal(t, recorder.txs[0], recorder.txs[1])
+
+	var searches int
+	node.handle("tx_search", func(json.RawMessage) (interface{}, error) {
+		if searches++; searches == 1 {
+			return nil, errDropConnection
+		}
+		return &ctypes.ResultTxSearch{}, nil
+	})
+	_, e := base.SearchTxs(sdk.NewEventQueryBuilder().AddCondition(sdk.NewCond("message", "action").EQ("send")), 1, 10, sdk.OrderAsc)
+	require.NoError(t, e)
+	require.Equal(t, 2, node.called("tx_search"))
+
+	// the errors of the node are not retried
+	node.handle("tx", func(json.RawMessage) (interface{}, error) {
+		if node.called("tx") == 1 {
+			return nil, errDropConnection
+		}
+		return nil, errors.New("tx not found")
+	})
+	_, e = base.QueryTx("AB")
+	require.Error(t, e)
+	require.Equal(t, 2, node.called("tx"))
+}
+
+func TestSendAttemptsAreNotMultiplied(t *testing.T) {
+	node := newTestNode(t)
+	base := newTestClient(t, node, sdk.RetryPolicyOption(retryAll))
+
+	var recorder broadcastRecorder
+	node.handle("broadcast_tx_sync", recorder.handler(t, reject(32, "account sequence mismatch, expected 5, got 0")))
+
+	msgs, baseTx := testSend(t, base)
+	_, err := base.BuildAndSend(msgs, baseTx)
+	require.True(t, errors.Is(err, sdk.ErrInvalidSequence), err)
+	// every attempt is signed again, but the attempts of the signings are counted together
+	require.Len(t, recorder.txs, retryAll.MaxAttempts)
+	require.NotEqual(t, recorder.txs[0], recorder.txs[1])
+}
+
+func TestSendResignsAfterMismatch(t *testing.T) {
+	node := newTestNode(t)
+	base := newTestClient(t, node, sdk.RetryPolicyOption(retryAll), sdk.CachedOption(true))
+
+	var recorder broadcastRecorder
+	node.handle("broadcast_tx_sync", recorder.handler(t, reject(32, "account sequence mismatch, expected 3, got 0"), accept))
+
+	msgs, baseTx := testSend(t, base)
+	_, err := base.BuildAndSend(msgs, baseTx)
+	require.NoError(t, err)
+	require.Len(t, recorder.txs, 2)
+	require.NotEqual(t, recorder.txs[0], recorder.txs[1])
+	require.Zero(t, node.called("tx"))
+
+	// the transaction is signed again with the expected sequence, which is consumed
+	from, e := base.QueryAddress("test", "password")
+	require.NoError(t, e)
+	_, sequence, e := base.sequences.acquire(context.Background(), from.String())
+	require.NoError(t, e)
+	require.Equal(t, uint64(4), sequence)
+}
+
+func TestSendLooksUpSubmittedTx(t *testing.T) {
+	found := func(params json.RawMessage) (interface{}, error) {
+		var p struct {
+			Hash []byte `json:"hash"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return &ctypes.ResultTx{Hash: p.Hash, Height: 10, TxResult: abci.ResponseDeliverTx{GasUsed: 100}}, nil
+	}
+	notFound := func(params json.RawMessage) (interface{}, error) {
+		return nil, fmt.Errorf("tx not found")
+	}
+
+	testCases := []struct {
+		name    string
+		answers []func(tx tmtypes.Tx) (interface{}, error)
+		lookup  func(params json.RawMessage) (interface{}, error)
+		height  int64
+	}{
+		{
+			name:    "sequence mismatch after a timeout",
+			answers: []func(tx tmtypes.Tx) (interface{}, error){timeout, reject(32, "account sequence mismatch, expected 1, got 0")},
+			lookup:  found,
+			height:  10,
+		},
+		{
+			name:    "in the mempool cache after a timeout",
+			answers: []func(tx tmtypes.Tx) (interface{}, error){timeout, reject(19, "tx already exists in cache")},
+			lookup:  notFound,
+		},
+		{
+			name:    "in the mempool cache",
+			answers: []func(tx tmtypes.Tx) (interface{}, error){reject(19, "tx already exists in cache")},
+			lookup:  found,
+			height:  10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := newTestNode(t)
+			base := newTestClient(t, node, sdk.RetryPolicyOption(retryAll))
+
+			var recorder broadcastRecorder
+			node.handle("broadcast_tx_sync", recorder.handler(t, tc.answers...))
+			node.handle("tx", tc.lookup)
+
+			msgs, baseTx := testSend(t, base)
+			res, err := base.BuildAndSend(msgs, baseTx)
+			require.NoError(t, err)
+			// the transaction is never signed again
+			require.Len(t, recorder.txs, len(tc.answers))
+			for _, tx := range recorder.txs {
+				require.Equal(t, recorder.txs[0], tx)
+			}
+			require.Equal(t, 1, node.called("tx"))
+			require.Equal(t, sdk.HexBytes(recorder.txs[0].Hash()).String(), res.Hash)
+			require.Equal(t, tc.height, res.Height)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// QueryBlock returns the block and the results of the block at height
+func (base baseClient) QueryBlock(height int64) (sdk.BlockDetail, error) {
+	return base.queryBlock(context.Background(), height)
+}
+
+// queryBlock fetches the block and the results of the block in parallel
+func (base baseClient) queryBlock(ctx context.Context, height int64) (sdk.BlockDetail, error) {
+	var block *ctypes.ResultBlock
+	var blockErr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		block, blockErr = base.Block(ctx, &height)
+	}()
+
+	blockResult, err := base.BlockResults(ctx, &height)
+	wg.Wait()
+	if blockErr != nil {
+		return sdk.BlockDetail{}, blockErr
+	}
+	if err != nil {
+		return sdk.BlockDetail{}, err
+	}
+
+	return sdk.BlockDetail{
+		BlockID:     block.BlockID,
+		Block:       sdk.ParseBlockWithDecoder(base.encodingConfig.Amino, base.txDecoder, block.Block),
+		BlockResult: sdk.ParseBlockResult(blockResult),
+	}, nil
+}
+
+// queryBlockWithRetry fetches the block again with the backoff of the retry policy,
+// as long as the policy classifies the error as retryable
+func (base baseClient) queryBlockWithRetry(ctx context.Context, height int64) (sdk.BlockDetail, error) {
+	policy := base.cfg.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		block, err := base.queryBlock(ctx, height)
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, err) {
+			return block, err
+		}
+
+		base.Logger().Debug("query block failed, retrying", "height", height, "attempt", attempt, "errMsg", err.Error())
+		if e := policy.Wait(ctx, attempt); e != nil {
+			return block, err
+		}
+	}
+}
+
+// StreamBlocks delivers the blocks in [from, to] to handler in order, the blocks are fetched in parallel
+// and retried on failure. If to is not positive, it catches up to the latest block and keeps delivering
+// the new blocks until ctx is done, without any gap between the historical and the live blocks.
+//
+// It returns when all the blocks are delivered, ctx is done, a block can not be fetched or handler
+// returns an error. The blocks delivered before an error are not delivered again.
+func (base baseClient) StreamBlocks(ctx context.Context, from, to int64, opts sdk.StreamBlocksOptions, handler sdk.BlockHandler) error {
+	opts = opts.Normalize()
+	if from <= 0 {
+		from = 1
+	}
+
+	if to > 0 {
+		if to < from {
+			return fmt.Errorf("invalid block range [%d, %d]", from, to)
+		}
+		return base.fetchBlocks(ctx, from, to, opts.Concurrency, handler)
+	}
+	return base.tailBlocks(ctx, from, opts, handler)
+}
+
+// fetchBlocks fetches the blocks in [from, to] with concurrency workers and delivers them in order,
+// at most 2*concurrency blocks are held waiting for the previous ones
+func (base baseClient) fetchBlocks(ctx context.Context, from, to int64, concurrency int, handler sdk.BlockHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		height int64
+		block  sdk.BlockDetail
+		err    error
+	}
+
+	window := make(chan struct{}, 2*concurrency)
+	workers := make(chan struct{}, concurrency)
+	results := make(chan result, 2*concurrency)
+
+	go func() {
+		for height := from; height <= to; height++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case workers <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(height int64) {
+				block, err := base.queryBlockWithRetry(ctx, height)
+				<-workers
+				select {
+				case results <- result{height: height, block: block, err: err}:
+				case <-ctx.Done():
+				}
+			}(height)
+		}
+	}()
+
+	pending := make(map[int64]sdk.BlockDetail)
+	for next := from; next <= to; {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case res := <-results:
+			if res.err != nil {
+				return fmt.Errorf("query block %d failed: %w", res.height, res.err)
+			}
+			pending[res.height] = res.block
+		}
+
+		for block, ok := pending[next]; ok; block, ok = pending[next] {
+			delete(pending, next)
+			if err := handler(block); err != nil {
+				return err
+			}
+			<-window
+			next++
+		}
+	}
+	return nil
+}
+
+// tailBlocks delivers the blocks from height from on, the new blocks are notified by the subscription
+// of the new block headers, and by polling the latest height in case an event is missed.
+func (base baseClient) tailBlocks(ctx context.Context, from int64, opts sdk.StreamBlocksOptions, handler sdk.BlockHandler) error {
+	var mu sync.Mutex
+	var latest int64
+	notify := make(chan struct{}, 1)
+	update := func(height int64) {
+		mu.Lock()
+		if height > latest {
+			latest = height
+		}
+		mu.Unlock()
+
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+	poll := func() error {
+		status, err := base.Status(ctx)
+		if err != nil {
+			return err
+		}
+		update(status.SyncInfo.LatestBlockHeight)
+		return nil
+	}
+
+	// subscribe before querying the latest height, so that no block is missed in between
+	subscription, err := base.SubscribeNewBlockHeader(func(data sdk.EventDataNewBlockHeader) {
+		update(data.Header.Height)
+	})
+	if err != nil {
+		base.Logger().Error("subscribe new block header failed, polling the latest height", "errMsg", err.Error())
+	} else {
+		defer func() { _ = base.Unsubscribe(subscription) }()
+	}
+
+	if err := poll(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	for next := from; ; {
+		mu.Lock()
+		target := latest
+		mu.Unlock()
+
+		if target >= next {
+			if err := base.fetchBlocks(ctx, next, target, opts.Concurrency, handler); err != nil {
+				return err
+			}
+			next = target + 1
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		case <-ticker.C:
+			if err := poll(); err != nil {
+				base.Logger().Error("query latest height failed", "errMsg", err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// blockChain fakes the blocks of a chain, the blocks at the lower heights are answered slower,
+// so that the blocks fetched in parallel complete out of order
+type blockChain struct {
+	mu     sync.Mutex
+	latest int64
+	// errors answered to the block queries at a height, in turn
+	errs map[int64][]error
+	// number of block queries by height
+	queries map[int64]int
+}
+
+func newBlockChain(node *testNode, latest int64) *blockChain {
+	chain := &blockChain{latest: latest, errs: make(map[int64][]error), queries: make(map[int64]int)}
+	node.handle("block", chain.block)
+	node.handle("block_results", func(params json.RawMessage) (interface{}, error) {
+		return &ctypes.ResultBlockResults{Height: parseHeight(params)}, nil
+	})
+	node.handle("status", func(json.RawMessage) (interface{}, error) {
+		chain.mu.Lock()
+		defer chain.mu.Unlock()
+		return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: chain.latest}}, nil
+	})
+	return chain
+}
+
+func (chain *blockChain) block(params json.RawMessage) (interface{}, error) {
+	height := parseHeight(params)
+
+	chain.mu.Lock()
+	chain.queries[height]++
+	var err error
+	if errs := chain.errs[height]; len(errs) > 0 {
+		err, chain.errs[height] = errs[0], errs[1:]
+	}
+	chain.mu.Unlock()
+
+	time.Sleep(time.Duration(10-height%10) * time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}, nil
+}
+
+// fail sets the errors answered to the next block queries at height, and resets the number of its queries
+func (chain *blockChain) fail(height int64, errs ...error) {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	chain.errs[height] = errs
+	chain.queries[height] = 0
+}
+
+func (chain *blockChain) queried(height int64) int {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	return chain.queries[height]
+}
+
+func (chain *blockChain) grow(height int64) {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	chain.latest = height
+}
+
+func parseHeight(params json.RawMessage) int64 {
+	var p struct {
+		Height *int64 `json:"height"`
+	}
+	if err := tmjson.Unmarshal(params, &p); err != nil || p.Height == nil {
+		return 0
+	}
+	return *p.Height
+}
+
+// collectBlocks returns a handler recording the heights of the blocks delivered
+func collectBlocks(heights *[]int64) sdk.BlockHandler {
+	return func(block sdk.BlockDetail) error {
+		*heights = append(*heights, block.Block.Height)
+		return nil
+	}
+}
+
+func heightRange(from, to int64) []int64 {
+	var heights []int64
+	for h := from; h <= to; h++ {
+		heights = append(heights, h)
+	}
+	return heights
+}
+
+func TestStreamBlocksInOrder(t *testing.T) {
+	node := newTestNode(t)
+	newBlockChain(node, 30)
+	base := newTestClient(t, node)
+
+	var heights []int64
+	err := base.StreamBlocks(context.Background(), 3, 25, sdk.StreamBlocksOptions{Concurrency: 4}, collectBlocks(&heights))
+	require.NoError(t, err)
+	require.Equal(t, heightRange(3, 25), heights)
+
+	err = base.StreamBlocks(context.Background(), 5, 4, sdk.StreamBlocksOptions{}, collectBlocks(&heights))
+	require.Error(t, err)
+}
+
+func TestStreamBlocksRetry(t *testing.T) {
+	node := newTestNode(t)
+	chain := newBlockChain(node, 30)
+	chain.fail(7, errors.New("unavailable"), errors.New("unavailable"))
+
+	// the errors classified as retryable by the policy are retried
+	base := newTestClient(t, node, sdk.RetryPolicyOption(retryAll))
+	var heights []int64
+	err := base.StreamBlocks(context.Background(), 1, 10, sdk.StreamBlocksOptions{Concurrency: 3}, collectBlocks(&heights))
+	require.NoError(t, err)
+	require.Equal(t, heightRange(1, 10), heights)
+	require.Equal(t, 3, chain.queried(7))
+
+	// the others stop the stream, the blocks delivered before stay in order
+	chain.fail(7, errors.New("invalid height"))
+	base = newTestClient(t, node, sdk.RetryPolicyOption(sdk.RetryPolicy{
+		MaxAttempts: 3,
+		Retryable:   func(err error) bool { return false },
+	}))
+	heights = nil
+	err = base.StreamBlocks(context.Background(), 1, 10, sdk.StreamBlocksOptions{Concurrency: 3}, collectBlocks(&heights))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query block 7 failed")
+	require.True(t, len(heights) < 7)
+	require.Equal(t, heightRange(1, int64(len(heights))), heights)
+	require.Equal(t, 1, chain.queried(7))
+}
+
+func TestStreamBlocksHandlerError(t *testing.T) {
+	node := newTestNode(t)
+	newBlockChain(node, 30)
+	base := newTestClient(t, node)
+
+	var heights []int64
+	stop := errors.New("stop")
+	err := base.StreamBlocks(context.Background(), 1, 20, sdk.StreamBlocksOptions{Concurrency: 4}, func(block sdk.BlockDetail) error {
+		if block.Block.Height == 9 {
+			return stop
+		}
+		heights = append(heights, block.Block.Height)
+		return nil
+	})
+	require.True(t, errors.Is(err, stop))
+	require.Equal(t, heightRange(1, 8), heights)
+}
+
+func TestStreamBlocksTail(t *testing.T) {
+	node := newTestNode(t)
+	chain := newBlockChain(node, 5)
+	base := newTestClient(t, node)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the chain grows while the earlier blocks are delivered, the stream catches up without any gap
+	var heights []int64
+	err := base.StreamBlocks(ctx, 2, 0, sdk.StreamBlocksOptions{Concurrency: 2, PollInterval: 10 * time.Millisecond}, func(block sdk.BlockDetail) error {
+		heights = append(heights, block.Block.Height)
+		switch block.Block.Height {
+		case 5:
+			chain.grow(9)
+		case 9:
+			chain.grow(12)
+		case 12:
+			cancel()
+		}
+		return nil
+	})
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Equal(t, heightRange(2, 12), heights)
+}
@@ -0,0 +1,219 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+)
+
+// errDropConnection is returned by the rpc handlers closing the connection without any response,
+// like a node going down
+var errDropConnection = errors.New("drop connection")
+
+// testNode fakes the grpc queries of the account and the fee token, and the tendermint rpc of a node
+type testNode struct {
+	mu            sync.Mutex
+	accountNumber uint64
+	sequence      uint64
+	// handlers of the rpc methods, by method name
+	rpc map[string]func(params json.RawMessage) (interface{}, error)
+	// rpc methods called, in order
+	calls []string
+
+	grpcAddr string
+	rpcURL   string
+}
+
+func newTestNode(t *testing.T) *testNode {
+	node := &testNode{rpc: make(map[string]func(params json.RawMessage) (interface{}, error))}
+	node.rpc["broadcast_tx_sync"] = func(params json.RawMessage) (interface{}, error) {
+		return &ctypes.ResultBroadcastTx{Hash: tmtypes.Tx(decodeTxParam(t, params)).Hash()}, nil
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	auth.RegisterQueryServer(server, &authServer{node: node})
+	bank.RegisterQueryServer(server, &bankServer{})
+	token.RegisterQueryServer(server, &tokenServer{})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	node.grpcAddr = lis.Addr().String()
+
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		node.mu.Lock()
+		node.calls = append(node.calls, req.Method)
+		handler, ok := node.rpc[req.Method]
+		node.mu.Unlock()
+
+		res := rpctypes.RPCMethodNotFoundError(req.ID)
+		if ok {
+			result, err := handler(req.Params)
+			if errors.Is(err, errDropConnection) {
+				conn, _, e := w.(http.Hijacker).Hijack()
+				require.NoError(t, e)
+				_ = conn.Close()
+				return
+			}
+			if err != nil {
+				res = rpctypes.RPCInternalError(req.ID, err)
+			} else {
+				res = rpctypes.NewRPCSuccessResponse(req.ID, result)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(rpcServer.Close)
+	node.rpcURL = rpcServer.URL
+	return node
+}
+
+// handle sets the handler of the rpc method
+func (node *testNode) handle(method string, handler func(params json.RawMessage) (interface{}, error)) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.rpc[method] = handler
+}
+
+// called returns the number of calls of the rpc method
+func (node *testNode) called(method string) int {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	var n int
+	for _, call := range node.calls {
+		if call == method {
+			n++
+		}
+	}
+	return n
+}
+
+// decodeTxParam returns the tx param of the broadcast_tx_* methods
+func decodeTxParam(t *testing.T, params json.RawMessage) []byte {
+	var p struct {
+		Tx []byte `json:"tx"`
+	}
+	require.NoError(t, json.Unmarshal(params, &p))
+	return p.Tx
+}
+
+type authServer struct {
+	auth.UnimplementedQueryServer
+	node *testNode
+}
+
+func (s authServer) Account(_ context.Context, req *auth.QueryAccountRequest) (*auth.QueryAccountResponse, error) {
+	s.node.mu.Lock()
+	defer s.node.mu.Unlock()
+
+	account, err := cdctypes.NewAnyWithValue(&auth.BaseAccount{
+		Address:       req.Address,
+		AccountNumber: s.node.accountNumber,
+		Sequence:      s.node.sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth.QueryAccountResponse{Account: account}, nil
+}
+
+type bankServer struct {
+	bank.UnimplementedQueryServer
+}
+
+func (bankServer) AllBalances(context.Context, *bank.QueryAllBalancesRequest) (*bank.QueryAllBalancesResponse, error) {
+	return &bank.QueryAllBalancesResponse{}, nil
+}
+
+type tokenServer struct {
+	token.UnimplementedQueryServer
+}
+
+func (tokenServer) Token(_ context.Context, req *token.QueryTokenRequest) (*token.QueryTokenResponse, error) {
+	if req.Denom != "iris" && req.Denom != "uiris" {
+		return nil, errors.New("token not found")
+	}
+	t, err := cdctypes.NewAnyWithValue(&token.Token{Symbol: "iris", Name: "IRIS", Scale: 6, MinUnit: "uiris"})
+	if err != nil {
+		return nil, err
+	}
+	return &token.QueryTokenResponse{Token: t}, nil
+}
+
+func testEncodingConfig() sdk.EncodingConfig {
+	amino := codec.NewLegacyAmino()
+	registry := cdctypes.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(registry)
+
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txtypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	cryptocodec.RegisterCrypto(amino)
+	bank.RegisterInterfaces(registry)
+	token.RegisterInterfaces(registry)
+
+	return sdk.EncodingConfig{
+		InterfaceRegistry: registry,
+		Marshaler:         marshaler,
+		TxConfig:          txtypes.NewTxConfig(marshaler, txtypes.DefaultSignModes),
+		Amino:             amino,
+	}
+}
+
+// newTestClient returns a client of the node whose key "test" is recovered from mnemonic
+func newTestClient(t *testing.T, node *testNode, options ...sdk.Option) *baseClient {
+	options = append([]sdk.Option{
+		sdk.KeyDAOOption(store.NewMemory(nil)),
+		sdk.RetryPolicyOption(sdk.RetryPolicy{MaxAttempts: 1}),
+	}, options...)
+	cfg, err := sdk.NewClientConfig(node.rpcURL, node.grpcAddr, "test", options...)
+	require.NoError(t, err)
+
+	base := NewBaseClient(cfg, testEncodingConfig(), log.NewNopLogger()).(*baseClient)
+	_, err = base.Recover("test", "password", mnemonic)
+	require.NoError(t, err)
+	return base
+}
+
+// testSend returns a MsgSend of the key "test" and the BaseTx sending it
+func testSend(t *testing.T, base *baseClient) ([]sdk.Msg, sdk.BaseTx) {
+	from, err := base.QueryAddress("test", "password")
+	require.NoError(t, err)
+
+	msg := &bank.MsgSend{
+		FromAddress: from.String(),
+		ToAddress:   from.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)),
+	}
+	return []sdk.Msg{msg}, sdk.BaseTx{From: "test", Password: "password", Mode: sdk.Sync}
+}
@@ -0,0 +1,130 @@
+package distribution
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type distributionClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return distributionClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (dc distributionClient) Name() string {
+	return ModuleName
+}
+
+func (dc distributionClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+// SetWithdrawAddress sets the address receiving the rewards of baseTx.From
+func (dc distributionClient) SetWithdrawAddress(withdrawAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegator, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(withdrawAddr); err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgSetWithdrawAddress{
+		DelegatorAddress: delegator.String(),
+		WithdrawAddress:  withdrawAddr,
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// WithdrawRewards withdraws the rewards of the delegations of baseTx.From to the validators in a single tx
+func (dc distributionClient) WithdrawRewards(validatorAddrs []string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	if len(validatorAddrs) == 0 {
+		return sdk.ResultTx{}, sdk.Wrapf("no validator to withdraw rewards from")
+	}
+
+	delegator, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msgs := make([]sdk.Msg, len(validatorAddrs))
+	for i, validatorAddr := range validatorAddrs {
+		if _, err := sdk.ValAddressFromBech32(validatorAddr); err != nil {
+			return sdk.ResultTx{}, sdk.Wrap(err)
+		}
+		msgs[i] = &MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validatorAddr,
+		}
+	}
+	return dc.BuildAndSend(msgs, baseTx)
+}
+
+// QueryRewards returns the rewards of the delegator for every validator and their total
+func (dc distributionClient) QueryRewards(delegatorAddr string) (QueryRewardsResp, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryRewardsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegationTotalRewards(
+		context.Background(),
+		&QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return QueryRewardsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryRewardsResp), nil
+}
+
+func (dc distributionClient) QueryDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegationRewards(
+		context.Background(),
+		&QueryDelegationRewardsRequest{
+			DelegatorAddress: delegatorAddr,
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Rewards, nil
+}
+
+func (dc distributionClient) QueryWithdrawAddress(delegatorAddr string) (string, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegatorWithdrawAddress(
+		context.Background(),
+		&QueryDelegatorWithdrawAddressRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+	return res.WithdrawAddress, nil
+}
@@ -0,0 +1,18 @@
+package distribution
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the distribution module of irishub, use errors.Is to match them
+var (
+	ErrEmptyDelegatorAddr      = sdk.Register(ModuleName, 2, "delegator address is empty")
+	ErrEmptyWithdrawAddr       = sdk.Register(ModuleName, 3, "withdraw address is empty")
+	ErrEmptyValidatorAddr      = sdk.Register(ModuleName, 4, "validator address is empty")
+	ErrEmptyDelegationDistInfo = sdk.Register(ModuleName, 5, "no delegation distribution info")
+	ErrNoValidatorDistInfo     = sdk.Register(ModuleName, 6, "no validator distribution info")
+	ErrNoValidatorCommission   = sdk.Register(ModuleName, 7, "no validator commission to withdraw")
+	ErrSetWithdrawAddrDisabled = sdk.Register(ModuleName, 8, "set withdraw address disabled")
+	ErrNoValidatorExists       = sdk.Register(ModuleName, 12, "validator does not exist")
+	ErrNoDelegationExists      = sdk.Register(ModuleName, 13, "delegation does not exist")
+)
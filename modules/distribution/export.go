@@ -0,0 +1,27 @@
+package distribution
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Distribution module api for user
+type Client interface {
+	sdk.Module
+
+	SetWithdrawAddress(withdrawAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	WithdrawRewards(validatorAddrs []string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryRewards(delegatorAddr string) (QueryRewardsResp, sdk.Error)
+	QueryDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryWithdrawAddress(delegatorAddr string) (string, sdk.Error)
+}
+
+type DelegationRewardResp struct {
+	ValidatorAddress string       `json:"validator_address"`
+	Reward           sdk.DecCoins `json:"reward"`
+}
+
+type QueryRewardsResp struct {
+	Rewards []DelegationRewardResp `json:"rewards"`
+	Total   sdk.DecCoins           `json:"total"`
+}
@@ -0,0 +1,132 @@
+package distribution
+
+import (
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "distribution"
+
+	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+
+	// limits of the title and the description of the gov proposals, see gov.ValidateAbstract
+	maxTitleLength       = 140
+	maxDescriptionLength = 5000
+)
+
+var (
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg = &MsgWithdrawDelegatorReward{}
+)
+
+func (msg MsgSetWithdrawAddress) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgSetWithdrawAddress) Type() string { return "set_withdraw_address" }
+
+// ValidateBasic implements Msg
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return sdk.Wrapf("missing delegator address")
+	}
+	if msg.WithdrawAddress == "" {
+		return sdk.Wrapf("missing withdraw address")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgWithdrawDelegatorReward) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgWithdrawDelegatorReward) Type() string { return "withdraw_delegator_reward" }
+
+// ValidateBasic implements Msg
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return sdk.Wrapf("missing delegator address")
+	}
+	if msg.ValidatorAddress == "" {
+		return sdk.Wrapf("missing validator address")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+func (q QueryDelegationTotalRewardsResponse) Convert() interface{} {
+	res := QueryRewardsResp{
+		Total: q.Total,
+	}
+	for _, r := range q.Rewards {
+		res.Rewards = append(res.Rewards, DelegationRewardResp{
+			ValidatorAddress: r.ValidatorAddress,
+			Reward:           r.Reward,
+		})
+	}
+	return res
+}
+
+// NewCommunityPoolSpendProposal creates a proposal content spending the community pool,
+// it implements gov.Content and is registered as a content type by the gov module
+func NewCommunityPoolSpendProposal(title, description, recipient string, amount sdk.Coins) *CommunityPoolSpendProposal {
+	return &CommunityPoolSpendProposal{Title: title, Description: description, Recipient: recipient, Amount: amount}
+}
+
+func (csp *CommunityPoolSpendProposal) GetTitle() string       { return csp.Title }
+func (csp *CommunityPoolSpendProposal) GetDescription() string { return csp.Description }
+func (csp *CommunityPoolSpendProposal) ProposalRoute() string  { return ModuleName }
+func (csp *CommunityPoolSpendProposal) ProposalType() string   { return ProposalTypeCommunityPoolSpend }
+
+// ValidateBasic validates the title, the description, the recipient and the amount of the proposal
+func (csp *CommunityPoolSpendProposal) ValidateBasic() error {
+	if len(strings.TrimSpace(csp.Title)) == 0 {
+		return sdk.Wrapf("proposal title cannot be blank")
+	}
+	if len(csp.Title) > maxTitleLength {
+		return sdk.Wrapf("proposal title is longer than max length of %d", maxTitleLength)
+	}
+	if len(csp.Description) == 0 {
+		return sdk.Wrapf("proposal description cannot be blank")
+	}
+	if len(csp.Description) > maxDescriptionLength {
+		return sdk.Wrapf("proposal description is longer than max length of %d", maxDescriptionLength)
+	}
+	if _, err := sdk.AccAddressFromBech32(csp.Recipient); err != nil {
+		return sdk.Wrapf("invalid recipient %s: %s", csp.Recipient, err.Error())
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return sdk.Wrapf("invalid amount %s", csp.Amount.String())
+	}
+	return nil
+}
+
+func (csp CommunityPoolSpendProposal) String() string {
+	out, _ := yaml.Marshal(csp)
+	return string(out)
+}
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/irisnet/irishub-sdk-go/codec"
 	"github.com/irisnet/irishub-sdk-go/codec/types"
 	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
@@ -21,5 +23,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgDeposit{},
 		&MsgVote{},
+		&MsgVoteWeighted{},
 	)
+
+	registry.RegisterInterface("cosmos.gov.v1beta1.Content", (*Content)(nil))
+	for _, content := range contents {
+		registry.RegisterImplementations((*Content)(nil), content.(proto.Message))
+	}
 }
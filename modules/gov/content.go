@@ -1,5 +1,14 @@
 package gov
 
+import (
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
 // Constants pertaining to a Content object
 const (
 	MaxDescriptionLength int = 5000
@@ -20,3 +29,164 @@ type Content interface {
 	ValidateBasic() error
 	String() string
 }
+
+// Proposal types of the content types beyond TextProposal
+const (
+	ProposalTypeParameterChange       string = "ParameterChange"
+	ProposalTypeSoftwareUpgrade       string = "SoftwareUpgrade"
+	ProposalTypeCancelSoftwareUpgrade string = "CancelSoftwareUpgrade"
+	ProposalTypeCommunityPoolSpend    string = distribution.ProposalTypeCommunityPoolSpend
+
+	routerKeyParams  = "params"
+	routerKeyUpgrade = "upgrade"
+)
+
+var (
+	_ Content = &ParameterChangeProposal{}
+	_ Content = &SoftwareUpgradeProposal{}
+	_ Content = &CancelSoftwareUpgradeProposal{}
+	_ Content = &distribution.CommunityPoolSpendProposal{}
+)
+
+// ValidateAbstract validates the title and the description of a proposal content
+func ValidateAbstract(c Content) error {
+	title := c.GetTitle()
+	if len(strings.TrimSpace(title)) == 0 {
+		return sdk.Wrapf("proposal title cannot be blank")
+	}
+	if len(title) > MaxTitleLength {
+		return sdk.Wrapf("proposal title is longer than max length of %d", MaxTitleLength)
+	}
+
+	description := c.GetDescription()
+	if len(description) == 0 {
+		return sdk.Wrapf("proposal description cannot be blank")
+	}
+	if len(description) > MaxDescriptionLength {
+		return sdk.Wrapf("proposal description is longer than max length of %d", MaxDescriptionLength)
+	}
+	return nil
+}
+
+// NewParameterChangeProposal creates a parameter change proposal Content
+func NewParameterChangeProposal(title, description string, changes []ParamChange) Content {
+	return &ParameterChangeProposal{Title: title, Description: description, Changes: changes}
+}
+
+func (pcp *ParameterChangeProposal) GetTitle() string       { return pcp.Title }
+func (pcp *ParameterChangeProposal) GetDescription() string { return pcp.Description }
+func (pcp *ParameterChangeProposal) ProposalRoute() string  { return routerKeyParams }
+func (pcp *ParameterChangeProposal) ProposalType() string   { return ProposalTypeParameterChange }
+
+// ValidateBasic validates the abstract and the changes of the proposal
+func (pcp *ParameterChangeProposal) ValidateBasic() error {
+	if err := ValidateAbstract(pcp); err != nil {
+		return err
+	}
+	if len(pcp.Changes) == 0 {
+		return sdk.Wrapf("proposal has no parameter change")
+	}
+	for _, pc := range pcp.Changes {
+		if err := pc.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pcp ParameterChangeProposal) String() string {
+	out, _ := yaml.Marshal(pcp)
+	return string(out)
+}
+
+// ValidateBasic validates that the subspace, the key and the value of the change are set
+func (pc ParamChange) ValidateBasic() error {
+	if len(pc.Subspace) == 0 {
+		return sdk.Wrapf("parameter change subspace cannot be blank")
+	}
+	if len(pc.Key) == 0 {
+		return sdk.Wrapf("parameter change key cannot be blank")
+	}
+	if len(pc.Value) == 0 {
+		return sdk.Wrapf("parameter change value cannot be blank")
+	}
+	return nil
+}
+
+func (pc ParamChange) String() string {
+	out, _ := yaml.Marshal(pc)
+	return string(out)
+}
+
+// ValidateBasic validates that the plan has a name and either a time or a height
+func (p Plan) ValidateBasic() error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return sdk.Wrapf("upgrade plan name cannot be blank")
+	}
+	if p.Height < 0 {
+		return sdk.Wrapf("upgrade plan height cannot be negative")
+	}
+	if p.Time.Unix() <= 0 && p.Height == 0 {
+		return sdk.Wrapf("upgrade plan must set either time or height")
+	}
+	if p.Time.Unix() > 0 && p.Height != 0 {
+		return sdk.Wrapf("upgrade plan cannot set both time and height")
+	}
+	return nil
+}
+
+func (p Plan) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// NewSoftwareUpgradeProposal creates a software upgrade proposal Content
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) Content {
+	return &SoftwareUpgradeProposal{Title: title, Description: description, Plan: plan}
+}
+
+func (sup *SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
+func (sup *SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+func (sup *SoftwareUpgradeProposal) ProposalRoute() string  { return routerKeyUpgrade }
+func (sup *SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic validates the abstract and the plan of the proposal
+func (sup *SoftwareUpgradeProposal) ValidateBasic() error {
+	if err := ValidateAbstract(sup); err != nil {
+		return err
+	}
+	return sup.Plan.ValidateBasic()
+}
+
+func (sup SoftwareUpgradeProposal) String() string {
+	out, _ := yaml.Marshal(sup)
+	return string(out)
+}
+
+// NewCancelSoftwareUpgradeProposal creates a proposal Content cancelling the planned software upgrade
+func NewCancelSoftwareUpgradeProposal(title, description string) Content {
+	return &CancelSoftwareUpgradeProposal{Title: title, Description: description}
+}
+
+func (csup *CancelSoftwareUpgradeProposal) GetTitle() string       { return csup.Title }
+func (csup *CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+func (csup *CancelSoftwareUpgradeProposal) ProposalRoute() string  { return routerKeyUpgrade }
+func (csup *CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+
+// ValidateBasic validates the abstract of the proposal
+func (csup *CancelSoftwareUpgradeProposal) ValidateBasic() error {
+	return ValidateAbstract(csup)
+}
+
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	out, _ := yaml.Marshal(csup)
+	return string(out)
+}
+
+// NewCommunityPoolSpendProposal creates a proposal Content spending the community pool,
+// see distribution.CommunityPoolSpendProposal
+func NewCommunityPoolSpendProposal(title, description, recipient string, amount sdk.Coins) Content {
+	return distribution.NewCommunityPoolSpendProposal(title, description, recipient, amount)
+}
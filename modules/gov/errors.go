@@ -0,0 +1,17 @@
+package gov
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the gov module of irishub, use errors.Is to match them
+var (
+	ErrUnknownProposal         = sdk.Register(ModuleName, 2, "unknown proposal")
+	ErrInactiveProposal        = sdk.Register(ModuleName, 3, "inactive proposal")
+	ErrAlreadyActiveProposal   = sdk.Register(ModuleName, 4, "proposal already active")
+	ErrInvalidProposalContent  = sdk.Register(ModuleName, 5, "invalid proposal content")
+	ErrInvalidProposalType     = sdk.Register(ModuleName, 6, "invalid proposal type")
+	ErrInvalidVote             = sdk.Register(ModuleName, 7, "invalid vote option")
+	ErrInvalidGenesis          = sdk.Register(ModuleName, 8, "invalid genesis state")
+	ErrNoProposalHandlerExists = sdk.Register(ModuleName, 9, "no handler exists for proposal type")
+)
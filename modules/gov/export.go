@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"context"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"time"
 )
@@ -9,17 +10,31 @@ import (
 type Client interface {
 	sdk.Module
 	SubmitProposal(request SubmitProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
+	SubmitParameterChangeProposal(request ParameterChangeProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
+	SubmitSoftwareUpgradeProposal(request SoftwareUpgradeProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
+	SubmitCancelSoftwareUpgradeProposal(request CancelSoftwareUpgradeProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
+	SubmitCommunityPoolSpendProposal(request CommunityPoolSpendProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
+	SubmitContentProposal(content Content, initialDeposit sdk.DecCoins, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
 	Deposit(request DepositRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	Vote(request VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	WeightedVote(request WeightedVoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	VoteMany(requests []VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	VoteOnBehalf(granter string, requests []VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 
 	QueryProposal(proposalId uint64) (QueryProposalResp, sdk.Error)
 	QueryProposals(proposalStatus string) ([]QueryProposalResp, sdk.Error)
+	QueryProposalsPage(request QueryProposalsReq) (QueryProposalsResp, sdk.Error)
 	QueryVote(proposalId uint64, voter string) (QueryVoteResp, sdk.Error)
 	QueryVotes(proposalId uint64) ([]QueryVoteResp, sdk.Error)
+	QueryVotesPage(proposalId uint64, page, size uint64) (QueryVotesResp, sdk.Error)
 	QueryParams(paramsType string) (QueryParamsResp, sdk.Error)
 	QueryDeposit(proposalId uint64, depositor string) (QueryDepositResp, sdk.Error)
 	QueryDeposits(proposalId uint64) ([]QueryDepositResp, sdk.Error)
+	QueryDepositsPage(proposalId uint64, page, size uint64) (QueryDepositsResp, sdk.Error)
 	QueryTallyResult(proposalId uint64) (QueryTallyResultResp, sdk.Error)
+	QueryLiveTally(proposalId uint64) (QueryLiveTallyResp, sdk.Error)
+
+	WatchProposals(ctx context.Context, opts WatchOptions, handler ProposalEventHandler) error
 }
 
 type SubmitProposalRequest struct {
@@ -29,6 +44,34 @@ type SubmitProposalRequest struct {
 	InitialDeposit sdk.DecCoins `json:"initial_deposit"`
 }
 
+type ParameterChangeProposalRequest struct {
+	Title          string        `json:"title"`
+	Description    string        `json:"description"`
+	Changes        []ParamChange `json:"changes"`
+	InitialDeposit sdk.DecCoins  `json:"initial_deposit"`
+}
+
+type SoftwareUpgradeProposalRequest struct {
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	Plan           Plan         `json:"plan"`
+	InitialDeposit sdk.DecCoins `json:"initial_deposit"`
+}
+
+type CancelSoftwareUpgradeProposalRequest struct {
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	InitialDeposit sdk.DecCoins `json:"initial_deposit"`
+}
+
+type CommunityPoolSpendProposalRequest struct {
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	Recipient      string       `json:"recipient"`
+	Amount         sdk.DecCoins `json:"amount"`
+	InitialDeposit sdk.DecCoins `json:"initial_deposit"`
+}
+
 type DepositRequest struct {
 	ProposalId uint64       `json:"proposal_id"`
 	Amount     sdk.DecCoins `json:"amount"`
@@ -39,6 +82,17 @@ type VoteRequest struct {
 	Option     string `json:"option"`
 }
 
+type WeightedVoteRequest struct {
+	ProposalId uint64                      `json:"proposal_id"`
+	Options    []WeightedVoteOptionRequest `json:"options"`
+}
+
+// about WeightedVoteOptionRequest.Option see VoteOption_value
+type WeightedVoteOptionRequest struct {
+	Option string  `json:"option"`
+	Weight sdk.Dec `json:"weight"`
+}
+
 type QueryProposalResp struct {
 	ProposalId       uint64               `json:"proposal_id"`
 	Content          Content              `json:"content"`
@@ -51,10 +105,31 @@ type QueryProposalResp struct {
 	VotingEndTime    time.Time            `json:"voting_end_time"`
 }
 
+// about QueryProposalsReq.Status see ProposalStatus_value, the page starts from 1 and the size is 10 by default
+type QueryProposalsReq struct {
+	Status    string `json:"status"`
+	Voter     string `json:"voter"`
+	Depositor string `json:"depositor"`
+	Page      uint64 `json:"page"`
+	Size      uint64 `json:"size"`
+}
+
+type QueryProposalsResp struct {
+	Proposals []QueryProposalResp `json:"proposals"`
+	Total     uint64              `json:"total"`
+}
+
 type QueryVoteResp struct {
 	ProposalId uint64 `json:"proposal_id"`
 	Voter      string `json:"voter"`
 	Option     int32  `json:"option"`
+	// options of a weighted vote, Option is set if and only if there is a single option of weight 1
+	Options []WeightedVoteOption `json:"options"`
+}
+
+type QueryVotesResp struct {
+	Votes []QueryVoteResp `json:"votes"`
+	Total uint64          `json:"total"`
 }
 
 type (
@@ -89,3 +164,29 @@ type QueryTallyResultResp struct {
 	No         sdk.Int `json:"no"`
 	NoWithVeto sdk.Int `json:"no_with_veto"`
 }
+
+type QueryDepositsResp struct {
+	Deposits []QueryDepositResp `json:"deposits"`
+	Total    uint64             `json:"total"`
+}
+
+type QueryLiveTallyResp struct {
+	ProposalId   uint64               `json:"proposal_id"`
+	Status       string               `json:"status"`
+	Tally        QueryTallyResultResp `json:"tally"`
+	BondedTokens sdk.Int              `json:"bonded_tokens"`
+	// share of the bonded tokens which voted
+	Turnout sdk.Dec `json:"turnout"`
+	// share of yes in the votes except abstain
+	YesRatio sdk.Dec `json:"yes_ratio"`
+	// share of no with veto in all the votes
+	VetoRatio        sdk.Dec `json:"veto_ratio"`
+	Quorum           sdk.Dec `json:"quorum"`
+	Threshold        sdk.Dec `json:"threshold"`
+	VetoThreshold    sdk.Dec `json:"veto_threshold"`
+	QuorumReached    bool    `json:"quorum_reached"`
+	ThresholdReached bool    `json:"threshold_reached"`
+	Vetoed           bool    `json:"vetoed"`
+	// whether the proposal would pass if the voting period ended now
+	Passing bool `json:"passing"`
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"github.com/irisnet/irishub-sdk-go/codec"
 	"github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
 	"strconv"
 )
 
@@ -30,20 +33,58 @@ func (gc govClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 }
 
 func (gc govClient) SubmitProposal(request SubmitProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
+	content := ContentFromProposalType(request.Title, request.Description, request.Type)
+	if content == nil {
+		return 0, sdk.ResultTx{}, sdk.Wrapf("proposal type %s can not be submitted with a title and a description only", request.Type)
+	}
+	return gc.SubmitContentProposal(content, request.InitialDeposit, baseTx)
+}
+
+func (gc govClient) SubmitParameterChangeProposal(request ParameterChangeProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
+	content := NewParameterChangeProposal(request.Title, request.Description, request.Changes)
+	return gc.SubmitContentProposal(content, request.InitialDeposit, baseTx)
+}
+
+func (gc govClient) SubmitSoftwareUpgradeProposal(request SoftwareUpgradeProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
+	content := NewSoftwareUpgradeProposal(request.Title, request.Description, request.Plan)
+	return gc.SubmitContentProposal(content, request.InitialDeposit, baseTx)
+}
+
+func (gc govClient) SubmitCancelSoftwareUpgradeProposal(request CancelSoftwareUpgradeProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
+	content := NewCancelSoftwareUpgradeProposal(request.Title, request.Description)
+	return gc.SubmitContentProposal(content, request.InitialDeposit, baseTx)
+}
+
+func (gc govClient) SubmitCommunityPoolSpendProposal(request CommunityPoolSpendProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
+	amount, err := gc.ToMinCoin(request.Amount...)
+	if err != nil {
+		return 0, sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	content := NewCommunityPoolSpendProposal(request.Title, request.Description, request.Recipient, amount)
+	return gc.SubmitContentProposal(content, request.InitialDeposit, baseTx)
+}
+
+// SubmitContentProposal submits a proposal of any content type, the custom content types
+// must be registered with RegisterProposalContent
+func (gc govClient) SubmitContentProposal(content Content, initialDeposit sdk.DecCoins, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
+	if !IsValidProposalType(content.ProposalType()) {
+		return 0, sdk.ResultTx{}, sdk.Wrapf("proposal type %s is not registered, see RegisterProposalContent", content.ProposalType())
+	}
+
 	proposer, err := gc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
 		return 0, sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
-	deposit, err := gc.ToMinCoin(request.InitialDeposit...)
+	deposit, err := gc.ToMinCoin(initialDeposit...)
 	if err != nil {
 		return 0, sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
-	content := ContentFromProposalType(request.Title, request.Description, request.Type)
 	msg, e := NewMsgSubmitProposal(content, deposit, proposer)
 	if e != nil {
-		return 0, sdk.ResultTx{}, sdk.Wrap(err)
+		return 0, sdk.ResultTx{}, sdk.Wrap(e)
 	}
 
 	result, err := gc.BuildAndSend([]sdk.Msg{msg}, baseTx)
@@ -98,6 +139,98 @@ func (gc govClient) Vote(request VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx,
 	return gc.BuildAndSend([]sdk.Msg{msg}, baseTx)
 }
 
+// WeightedVote splits the vote of baseTx.From across several options, the weights must sum to 1.
+// It requires a chain running the gov module of cosmos-sdk v0.43+
+func (gc govClient) WeightedVote(request WeightedVoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	voter, err := gc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgVoteWeighted{
+		ProposalId: request.ProposalId,
+		Voter:      voter.String(),
+	}
+	for _, o := range request.Options {
+		option, ok := VoteOption_value[o.Option]
+		if !ok {
+			return sdk.ResultTx{}, sdk.Wrapf("invalid vote option %s", o.Option)
+		}
+		msg.Options = append(msg.Options, WeightedVoteOption{
+			Option: VoteOption(option),
+			Weight: o.Weight,
+		})
+	}
+	if e := msg.ValidateBasic(); e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+	return gc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// VoteMany votes on several proposals as baseTx.From in a single tx
+func (gc govClient) VoteMany(requests []VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	voter, err := gc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msgs, err := newMsgVotes(voter.String(), requests)
+	if err != nil {
+		return sdk.ResultTx{}, err
+	}
+	return gc.BuildAndSend(msgs, baseTx)
+}
+
+// VoteOnBehalf votes on several proposals as the granter in a single tx signed by baseTx.From, the granter,
+// e.g. a validator operator account, must have granted baseTx.From to execute MsgVote with the authz module.
+// It requires a chain running the authz module of cosmos-sdk v0.43+
+func (gc govClient) VoteOnBehalf(granter string, requests []VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	if _, err := sdk.AccAddressFromBech32(granter); err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	grantee, err := gc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msgs, err := newMsgVotes(granter, requests)
+	if err != nil {
+		return sdk.ResultTx{}, err
+	}
+
+	msg, e := authz.NewMsgExec(grantee, msgs)
+	if e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+	return gc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// newMsgVotes creates the votes of the voter, about VoteRequest.Option see VoteOption_value
+func newMsgVotes(voter string, requests []VoteRequest) ([]sdk.Msg, sdk.Error) {
+	if len(requests) == 0 {
+		return nil, sdk.Wrapf("no vote to send")
+	}
+
+	msgs := make([]sdk.Msg, len(requests))
+	for i, request := range requests {
+		option, ok := VoteOption_value[request.Option]
+		if !ok {
+			return nil, sdk.Wrapf("invalid vote option %s", request.Option)
+		}
+		msg := &MsgVote{
+			ProposalId: request.ProposalId,
+			Voter:      voter,
+			Option:     VoteOption(option),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, sdk.Wrap(err)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
 func (gc govClient) QueryProposal(proposalId uint64) (QueryProposalResp, sdk.Error) {
 	conn, err := gc.GenConn()
 	defer func() { _ = conn.Close() }()
@@ -113,12 +246,21 @@ func (gc govClient) QueryProposal(proposalId uint64) (QueryProposalResp, sdk.Err
 	if err != nil {
 		return QueryProposalResp{}, sdk.Wrap(err)
 	}
+
+	if err := res.Proposal.UnpackInterfaces(gc.Marshaler); err != nil {
+		return QueryProposalResp{}, sdk.Wrap(err)
+	}
 	return res.Proposal.Convert().(QueryProposalResp), nil
 }
 
-// if proposalStatus is nil will return all status's proposals
-// about proposalStatus see VoteOption_value
+// if proposalStatus is empty will return all status's proposals
+// about proposalStatus see ProposalStatus_value
 func (gc govClient) QueryProposals(proposalStatus string) ([]QueryProposalResp, sdk.Error) {
+	status, e := parseProposalStatus(proposalStatus)
+	if e != nil {
+		return nil, e
+	}
+
 	conn, err := gc.GenConn()
 	defer func() { _ = conn.Close() }()
 	if err != nil {
@@ -128,7 +270,7 @@ func (gc govClient) QueryProposals(proposalStatus string) ([]QueryProposalResp,
 	res, err := NewQueryClient(conn).Proposals(
 		context.Background(),
 		&QueryProposalsRequest{
-			ProposalStatus: ProposalStatus(VoteOption_value[proposalStatus]),
+			ProposalStatus: status,
 			Pagination: &query.PageRequest{
 				Offset:     0,
 				Limit:      100,
@@ -138,9 +280,63 @@ func (gc govClient) QueryProposals(proposalStatus string) ([]QueryProposalResp,
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
+
+	if err := Proposals(res.Proposals).UnpackInterfaces(gc.Marshaler); err != nil {
+		return nil, sdk.Wrap(err)
+	}
 	return Proposals(res.Proposals).Convert().([]QueryProposalResp), nil
 }
 
+// QueryProposalsPage returns a page of the proposals filtered by status, voter and depositor, the empty filters are ignored
+func (gc govClient) QueryProposalsPage(request QueryProposalsReq) (QueryProposalsResp, sdk.Error) {
+	status, e := parseProposalStatus(request.Status)
+	if e != nil {
+		return QueryProposalsResp{}, e
+	}
+
+	conn, err := gc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryProposalsResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(request.Page, request.Size)
+	res, err := NewQueryClient(conn).Proposals(
+		context.Background(),
+		&QueryProposalsRequest{
+			ProposalStatus: status,
+			Voter:          request.Voter,
+			Depositor:      request.Depositor,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		})
+	if err != nil {
+		return QueryProposalsResp{}, sdk.Wrap(err)
+	}
+
+	if err := Proposals(res.Proposals).UnpackInterfaces(gc.Marshaler); err != nil {
+		return QueryProposalsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryProposalsResp), nil
+}
+
+// parseProposalStatus returns the proposal status of the name, see ProposalStatus_value,
+// the empty name is the unspecified status which matches all the proposals
+func parseProposalStatus(name string) (ProposalStatus, sdk.Error) {
+	if len(name) == 0 {
+		return StatusNil, nil
+	}
+
+	status, ok := ProposalStatus_value[name]
+	if !ok {
+		return StatusNil, sdk.Wrapf("invalid proposal status %s", name)
+	}
+	return ProposalStatus(status), nil
+}
+
 // about QueryVoteResp.Option see VoteOption_name
 func (gc govClient) QueryVote(proposalId uint64, voter string) (QueryVoteResp, sdk.Error) {
 	conn, err := gc.GenConn()
@@ -184,6 +380,30 @@ func (gc govClient) QueryVotes(proposalId uint64) ([]QueryVoteResp, sdk.Error) {
 	return Votes(res.Votes).Convert().([]QueryVoteResp), nil
 }
 
+func (gc govClient) QueryVotesPage(proposalId uint64, page, size uint64) (QueryVotesResp, sdk.Error) {
+	conn, err := gc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryVotesResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).Votes(
+		context.Background(),
+		&QueryVotesRequest{
+			ProposalId: proposalId,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		})
+	if err != nil {
+		return QueryVotesResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryVotesResp), nil
+}
+
 // QueryParams params_type("voting", "tallying", "deposit"), if don't pass will return all params_typ res
 func (gc govClient) QueryParams(paramsType string) (QueryParamsResp, sdk.Error) {
 	conn, err := gc.GenConn()
@@ -248,6 +468,31 @@ func (gc govClient) QueryDeposits(proposalId uint64) ([]QueryDepositResp, sdk.Er
 	return Deposits(res.Deposits).Convert().([]QueryDepositResp), nil
 }
 
+func (gc govClient) QueryDepositsPage(proposalId uint64, page, size uint64) (QueryDepositsResp, sdk.Error) {
+	conn, err := gc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryDepositsResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).Deposits(
+		context.Background(),
+		&QueryDepositsRequest{
+			ProposalId: proposalId,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryDepositsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryDepositsResp), nil
+}
+
 func (gc govClient) QueryTallyResult(proposalId uint64) (QueryTallyResultResp, sdk.Error) {
 	conn, err := gc.GenConn()
 	defer func() { _ = conn.Close() }()
@@ -266,3 +511,42 @@ func (gc govClient) QueryTallyResult(proposalId uint64) (QueryTallyResultResp, s
 	}
 	return res.Tally.Convert().(QueryTallyResultResp), nil
 }
+
+// QueryLiveTally returns the current tally of the proposal with the turnout of the bonded tokens and whether the quorum,
+// the threshold and the veto threshold of the tally params are reached, i.e. the outcome if the voting period ended now.
+// The tally of a proposal out of the voting period is the final one, while the bonded tokens are the current ones
+func (gc govClient) QueryLiveTally(proposalId uint64) (QueryLiveTallyResp, sdk.Error) {
+	conn, err := gc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryLiveTallyResp{}, sdk.Wrap(err)
+	}
+
+	pool, err := staking.NewQueryClient(conn).Pool(
+		context.Background(),
+		&staking.QueryPoolRequest{},
+	)
+	if err != nil {
+		return QueryLiveTallyResp{}, sdk.Wrap(err)
+	}
+
+	proposal, e := gc.QueryProposal(proposalId)
+	if e != nil {
+		return QueryLiveTallyResp{}, e
+	}
+
+	tally, e := gc.QueryTallyResult(proposalId)
+	if e != nil {
+		return QueryLiveTallyResp{}, e
+	}
+
+	params, e := gc.QueryParams("tallying")
+	if e != nil {
+		return QueryLiveTallyResp{}, e
+	}
+
+	res := newLiveTally(tally, pool.Pool.BondedTokens, params.TallyParams)
+	res.ProposalId = proposalId
+	res.Status = proposal.Status
+	return res, nil
+}
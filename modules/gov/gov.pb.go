@@ -8,12 +8,12 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types1 "github.com/irisnet/irishub-sdk-go/codec/types"
 	github_com_irisnet_irishub_sdk_go_types "github.com/irisnet/irishub-sdk-go/types"
 	types "github.com/irisnet/irishub-sdk-go/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -121,6 +121,44 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                                  `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_irisnet_irishub_sdk_go_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/irisnet/irishub-sdk-go/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval.
 type TextProposal struct {
@@ -131,7 +169,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +209,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +254,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +294,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,15 +326,18 @@ var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
-	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
-	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Deprecated: Prefer to use `options` instead. This field is set in queries
+	// if and only if `len(options) == 1` and that option has weight 1.
+	Option  VoteOption           `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +378,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +416,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +460,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +492,7 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
@@ -464,94 +506,98 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xbf, 0x6f, 0xdb, 0xd6,
-	0x16, 0x16, 0xe5, 0xdf, 0x57, 0xb2, 0xcd, 0x5c, 0x3b, 0xb6, 0xcc, 0x97, 0x47, 0xf2, 0xf1, 0x75,
-	0x08, 0xd2, 0x58, 0x6e, 0x9c, 0xfe, 0x40, 0x9d, 0x49, 0xb2, 0x98, 0x54, 0x41, 0x62, 0x09, 0x14,
-	0xa3, 0x20, 0xed, 0x40, 0x50, 0xe6, 0x8d, 0xc4, 0x96, 0xe4, 0x55, 0xc5, 0x2b, 0x37, 0x42, 0x97,
-	0x8e, 0x81, 0x8a, 0xb6, 0xd9, 0x9a, 0x45, 0x80, 0x81, 0x6e, 0x9d, 0xfb, 0x1f, 0x74, 0x09, 0x8a,
-	0x0e, 0x41, 0xd1, 0x21, 0x28, 0x0a, 0xa5, 0x71, 0x80, 0x22, 0xf0, 0xe8, 0xb5, 0x4b, 0x41, 0xde,
-	0x4b, 0x8b, 0x92, 0x0c, 0x38, 0xf1, 0x14, 0xf2, 0xdc, 0xf3, 0x7d, 0xdf, 0xb9, 0x1f, 0xcf, 0x39,
-	0x8a, 0xc1, 0x85, 0x5d, 0xec, 0xbb, 0xd8, 0xdf, 0xa8, 0xe3, 0xbd, 0x8d, 0xbd, 0x2b, 0x35, 0x44,
-	0xcc, 0x2b, 0xc1, 0x73, 0xb6, 0xd9, 0xc2, 0x04, 0x43, 0x48, 0x4f, 0xb3, 0x41, 0x84, 0x9d, 0x0a,
-	0x22, 0x43, 0xd4, 0x4c, 0x1f, 0x1d, 0x43, 0x76, 0xb1, 0xed, 0x51, 0x8c, 0xb0, 0x5c, 0xc7, 0x75,
-	0x1c, 0x3e, 0x6e, 0x04, 0x4f, 0x2c, 0xba, 0x46, 0x51, 0x06, 0x3d, 0x60, 0xb4, 0xf4, 0x48, 0xaa,
-	0x63, 0x5c, 0x77, 0xd0, 0x46, 0xf8, 0x56, 0x6b, 0xdf, 0xdf, 0x20, 0xb6, 0x8b, 0x7c, 0x62, 0xba,
-	0xcd, 0x08, 0x3b, 0x9a, 0x60, 0x7a, 0x1d, 0x76, 0x24, 0x8e, 0x1e, 0x59, 0xed, 0x96, 0x49, 0x6c,
-	0xcc, 0x8a, 0x51, 0xee, 0x82, 0xb4, 0x8e, 0x1e, 0x90, 0x72, 0x0b, 0x37, 0xb1, 0x6f, 0x3a, 0x70,
-	0x19, 0x4c, 0x11, 0x9b, 0x38, 0x28, 0xc3, 0xc9, 0xdc, 0xc5, 0x39, 0x8d, 0xbe, 0x40, 0x19, 0xa4,
-	0x2c, 0xe4, 0xef, 0xb6, 0xec, 0x66, 0x00, 0xcd, 0x24, 0xc3, 0xb3, 0x78, 0x68, 0x6b, 0xf1, 0xd5,
-	0xbe, 0xc4, 0xfd, 0xf6, 0xd3, 0xfa, 0xcc, 0x36, 0xf6, 0x08, 0xf2, 0x88, 0xf2, 0x3b, 0x07, 0x66,
-	0x0a, 0xa8, 0x89, 0x7d, 0x9b, 0xc0, 0x0f, 0x40, 0xaa, 0xc9, 0x04, 0x0c, 0xdb, 0x0a, 0xa9, 0x27,
-	0xf3, 0x2b, 0x47, 0x7d, 0x09, 0x76, 0x4c, 0xd7, 0xd9, 0x52, 0x62, 0x87, 0x8a, 0x06, 0xa2, 0xb7,
-	0xa2, 0x05, 0x2f, 0x80, 0x39, 0x8b, 0x72, 0xe0, 0x16, 0x53, 0x1d, 0x04, 0x60, 0x03, 0x4c, 0x9b,
-	0x2e, 0x6e, 0x7b, 0x24, 0x33, 0x21, 0x4f, 0x5c, 0x4c, 0x6d, 0xae, 0x65, 0x99, 0x6d, 0x81, 0xf3,
-	0xd1, 0xe7, 0xc8, 0x6e, 0x63, 0xdb, 0xcb, 0xbf, 0xf7, 0xa4, 0x2f, 0x25, 0x7e, 0x7c, 0x2e, 0xad,
-	0xd7, 0x6d, 0xd2, 0x68, 0xd7, 0xb2, 0xbb, 0xd8, 0xdd, 0xb0, 0x5b, 0xb6, 0xef, 0x21, 0x12, 0xfe,
-	0xdb, 0x68, 0xd7, 0xd6, 0x7d, 0xeb, 0xb3, 0xf5, 0x3a, 0xde, 0x20, 0x9d, 0x26, 0xf2, 0x43, 0x94,
-	0xaf, 0x31, 0xfe, 0xad, 0xd9, 0x87, 0xfb, 0x52, 0xe2, 0xd5, 0xbe, 0x94, 0x50, 0xfe, 0x99, 0x06,
-	0xb3, 0xc7, 0x66, 0xbd, 0x7b, 0xd2, 0xbd, 0x96, 0x0e, 0xfb, 0x52, 0xd2, 0xb6, 0x8e, 0xfa, 0xd2,
-	0x1c, 0xbd, 0xdd, 0xe8, 0xa5, 0xae, 0x81, 0x99, 0x5d, 0x6a, 0x52, 0x78, 0xa5, 0xd4, 0xe6, 0x72,
-	0x96, 0x7e, 0xa4, 0x6c, 0xf4, 0x91, 0xb2, 0x39, 0xaf, 0x93, 0x4f, 0xfd, 0x32, 0x70, 0x53, 0x8b,
-	0x10, 0xb0, 0x0a, 0xa6, 0x7d, 0x62, 0x92, 0xb6, 0x9f, 0x99, 0x90, 0xb9, 0x8b, 0x0b, 0x9b, 0x4a,
-	0x76, 0xbc, 0x03, 0xb3, 0x51, 0x81, 0x95, 0x30, 0x33, 0x2f, 0x1c, 0xf5, 0xa5, 0x95, 0x11, 0xa7,
-	0x29, 0x89, 0xa2, 0x31, 0x36, 0xd8, 0x04, 0xf0, 0xbe, 0xed, 0x99, 0x8e, 0x41, 0x4c, 0xc7, 0xe9,
-	0x18, 0x2d, 0xe4, 0xb7, 0x1d, 0x92, 0x99, 0x0c, 0xeb, 0x93, 0x4e, 0xd2, 0xd0, 0x83, 0x3c, 0x2d,
-	0x4c, 0xcb, 0xff, 0x2f, 0x70, 0xf7, 0xa8, 0x2f, 0xad, 0x51, 0x91, 0x71, 0x22, 0x45, 0xe3, 0xc3,
-	0x60, 0x0c, 0x04, 0x3f, 0x01, 0x29, 0xbf, 0x5d, 0x73, 0x6d, 0x62, 0x04, 0xed, 0x9c, 0x99, 0x0a,
-	0xa5, 0x84, 0x31, 0x2b, 0xf4, 0xa8, 0xd7, 0xf3, 0x22, 0x53, 0x61, 0x4d, 0x13, 0x03, 0x2b, 0x8f,
-	0x9e, 0x4b, 0x9c, 0x06, 0x68, 0x24, 0x00, 0x40, 0x1b, 0xf0, 0xac, 0x4f, 0x0c, 0xe4, 0x59, 0x54,
-	0x61, 0xfa, 0x54, 0x85, 0xff, 0x33, 0x85, 0x55, 0xaa, 0x30, 0xca, 0x40, 0x65, 0x16, 0x58, 0x58,
-	0xf5, 0xac, 0x50, 0xea, 0x1b, 0x0e, 0xcc, 0x13, 0x4c, 0x4c, 0xc7, 0x60, 0x07, 0x99, 0x99, 0xd3,
-	0xba, 0xf1, 0x36, 0xd3, 0x59, 0xa6, 0x3a, 0x43, 0x68, 0xe5, 0xcd, 0xbb, 0x34, 0x1d, 0x12, 0x44,
-	0xc3, 0xe6, 0x80, 0x73, 0x7b, 0x98, 0xd8, 0x5e, 0x3d, 0xf8, 0xc6, 0x2d, 0xe6, 0xee, 0xec, 0xa9,
-	0x77, 0x7f, 0x8b, 0xd5, 0x94, 0xa1, 0x35, 0x8d, 0x51, 0xd0, 0xcb, 0x2f, 0xd2, 0x78, 0x25, 0x08,
-	0x87, 0xb7, 0xbf, 0x0f, 0x58, 0x68, 0xe0, 0xf3, 0xdc, 0xa9, 0x5a, 0x0a, 0xd3, 0x5a, 0x19, 0xd2,
-	0x1a, 0xb6, 0x79, 0x9e, 0x46, 0x99, 0xcb, 0x5b, 0x93, 0xc1, 0x7e, 0x51, 0xfe, 0x4c, 0x82, 0x54,
-	0xbc, 0x87, 0x54, 0x30, 0xd1, 0x41, 0x3e, 0xdd, 0x55, 0xf9, 0xab, 0x01, 0xeb, 0x1f, 0x7d, 0xe9,
-	0xed, 0xd7, 0x75, 0xaf, 0xe8, 0x11, 0x2d, 0xc0, 0xc3, 0xdb, 0x60, 0xc6, 0xac, 0xf9, 0xc4, 0xb4,
-	0xd9, 0x6a, 0x3b, 0x1b, 0x55, 0xc4, 0x01, 0xb7, 0x41, 0xd2, 0xc3, 0xe1, 0x7c, 0x9e, 0x91, 0x29,
-	0xe9, 0x61, 0xe8, 0x80, 0xb4, 0x87, 0x8d, 0x2f, 0x6c, 0xd2, 0x30, 0xf6, 0x10, 0xc1, 0xe1, 0x28,
-	0xce, 0xe5, 0x6f, 0x9e, 0x81, 0xee, 0xa8, 0x2f, 0x2d, 0x51, 0xa3, 0xe3, 0x84, 0x8a, 0x06, 0x3c,
-	0x7c, 0xd7, 0x26, 0x8d, 0x2a, 0x22, 0x98, 0xd9, 0xfb, 0x3d, 0x07, 0x26, 0xab, 0x98, 0xa0, 0xb3,
-	0x2f, 0xec, 0x65, 0x30, 0xb5, 0x87, 0x09, 0x8a, 0x96, 0x35, 0x7d, 0x81, 0xef, 0x83, 0x69, 0x4c,
-	0x7f, 0x39, 0xe8, 0xd2, 0x12, 0x4f, 0x5a, 0x28, 0x81, 0x70, 0x29, 0xcc, 0xd2, 0x58, 0xf6, 0xd6,
-	0xec, 0xe3, 0x68, 0xed, 0xfe, 0x9c, 0x04, 0xf3, 0xac, 0xc1, 0xcb, 0x66, 0xcb, 0x74, 0x7d, 0xb8,
-	0xcf, 0x81, 0x94, 0x6b, 0x7b, 0xc7, 0x43, 0xc7, 0x9d, 0x36, 0x74, 0x56, 0x60, 0xdd, 0x61, 0x5f,
-	0x3a, 0x1f, 0x43, 0x5d, 0xc6, 0xae, 0x4d, 0x90, 0xdb, 0x24, 0x9d, 0xc1, 0xdd, 0x62, 0xc7, 0x67,
-	0x98, 0x45, 0xe0, 0xda, 0x5e, 0x34, 0x89, 0xdf, 0x72, 0x00, 0xba, 0xe6, 0x83, 0x88, 0xcd, 0x68,
-	0xa2, 0x96, 0x8d, 0x2d, 0xb6, 0xf4, 0xd7, 0xc6, 0xe6, 0xa3, 0xc0, 0x7e, 0x99, 0xf3, 0x2a, 0xab,
-	0xf4, 0xc2, 0x38, 0x78, 0xa8, 0x60, 0xb6, 0x6e, 0xc7, 0xb3, 0x94, 0xc7, 0xc1, 0x04, 0xf1, 0xae,
-	0xf9, 0x20, 0xf2, 0x8c, 0x86, 0xbf, 0xe6, 0x40, 0xba, 0x1a, 0x8e, 0x15, 0x33, 0xf1, 0x4b, 0xc0,
-	0xc6, 0x2c, 0xaa, 0x8d, 0x3b, 0xad, 0xb6, 0x6b, 0xac, 0xb6, 0xd5, 0x21, 0xdc, 0x50, 0x59, 0xcb,
-	0x43, 0x53, 0x1d, 0xaf, 0x28, 0x4d, 0x63, 0xac, 0x9a, 0xc3, 0x68, 0x98, 0x59, 0x31, 0x06, 0x98,
-	0xfe, 0xbc, 0x8d, 0x5b, 0x6d, 0x37, 0xac, 0x22, 0x9d, 0xbf, 0xf1, 0xa6, 0xbd, 0x5e, 0x40, 0xbb,
-	0x87, 0x7d, 0x89, 0xa7, 0x24, 0x83, 0x92, 0x34, 0x46, 0x0b, 0x1b, 0x60, 0x8e, 0x34, 0x5a, 0xc8,
-	0x6f, 0x60, 0x87, 0x7e, 0x85, 0xf4, 0x9b, 0xcf, 0x13, 0xd5, 0x58, 0x3a, 0xe6, 0x89, 0xc9, 0x0c,
-	0xc8, 0xe1, 0x77, 0x1c, 0x58, 0x08, 0x86, 0xcc, 0x18, 0xe8, 0x4d, 0x84, 0x7a, 0x8d, 0xb3, 0xe9,
-	0x65, 0x86, 0xc9, 0x86, 0xec, 0x3e, 0xcf, 0xec, 0x1e, 0xca, 0x50, 0xb4, 0xf9, 0x20, 0xa0, 0x47,
-	0xef, 0x97, 0xfe, 0xe6, 0x00, 0x18, 0x4c, 0x18, 0xbc, 0x0c, 0x56, 0xab, 0x25, 0x5d, 0x35, 0x4a,
-	0x65, 0xbd, 0x58, 0xda, 0x31, 0xee, 0xec, 0x54, 0xca, 0xea, 0x76, 0xf1, 0x7a, 0x51, 0x2d, 0xf0,
-	0x09, 0x61, 0xb1, 0xdb, 0x93, 0x53, 0x34, 0x51, 0x0d, 0x44, 0xa0, 0x02, 0x16, 0xe3, 0xd9, 0xf7,
-	0xd4, 0x0a, 0xcf, 0x09, 0xf3, 0xdd, 0x9e, 0x3c, 0x47, 0xb3, 0xee, 0x21, 0x1f, 0x5e, 0x02, 0x4b,
-	0xf1, 0x9c, 0x5c, 0xbe, 0xa2, 0xe7, 0x8a, 0x3b, 0x7c, 0x52, 0x38, 0xd7, 0xed, 0xc9, 0xf3, 0x34,
-	0x2f, 0xc7, 0x16, 0xa4, 0x0c, 0x16, 0xe2, 0xb9, 0x3b, 0x25, 0x7e, 0x42, 0x48, 0x77, 0x7b, 0xf2,
-	0x2c, 0x4d, 0xdb, 0xc1, 0x70, 0x13, 0x64, 0x86, 0x33, 0x8c, 0xbb, 0x45, 0xfd, 0x23, 0xa3, 0xaa,
-	0xea, 0x25, 0x7e, 0x52, 0x58, 0xee, 0xf6, 0x64, 0x3e, 0xca, 0x8d, 0x76, 0x98, 0x30, 0xf9, 0xf0,
-	0x07, 0x31, 0x71, 0xe9, 0xd7, 0x24, 0x58, 0x18, 0xfe, 0xff, 0x0f, 0xcc, 0x82, 0xff, 0x94, 0xb5,
-	0x52, 0xb9, 0x54, 0xc9, 0xdd, 0x32, 0x2a, 0x7a, 0x4e, 0xbf, 0x53, 0x19, 0xb9, 0x70, 0x78, 0x15,
-	0x9a, 0xbc, 0x63, 0x3b, 0xf0, 0x1a, 0x10, 0x47, 0xf3, 0x0b, 0x6a, 0xb9, 0x54, 0x29, 0xea, 0x46,
-	0x59, 0xd5, 0x8a, 0xa5, 0x02, 0xcf, 0x09, 0xab, 0xdd, 0x9e, 0xbc, 0x44, 0x21, 0x43, 0x33, 0x06,
-	0x3f, 0x04, 0xff, 0x1d, 0x05, 0x57, 0x4b, 0x7a, 0x71, 0xe7, 0x46, 0x84, 0x4d, 0x0a, 0x2b, 0xdd,
-	0x9e, 0x0c, 0x29, 0xb6, 0x1a, 0x1b, 0x08, 0x78, 0x19, 0xac, 0x8c, 0x42, 0xcb, 0xb9, 0x4a, 0x45,
-	0x2d, 0xf0, 0x13, 0x02, 0xdf, 0xed, 0xc9, 0x69, 0x8a, 0x29, 0x9b, 0xbe, 0x8f, 0x2c, 0xf8, 0x0e,
-	0xc8, 0x8c, 0x66, 0x6b, 0xea, 0x4d, 0x75, 0x5b, 0x57, 0x0b, 0xfc, 0xa4, 0x00, 0xbb, 0x3d, 0x79,
-	0x81, 0xe6, 0x6b, 0xe8, 0x53, 0xb4, 0x4b, 0xd0, 0x89, 0xfc, 0xd7, 0x73, 0xc5, 0x5b, 0x6a, 0x81,
-	0x9f, 0x8a, 0xf3, 0x5f, 0x37, 0x6d, 0x07, 0x59, 0xd4, 0xce, 0x7c, 0xe5, 0xc9, 0x0b, 0x31, 0xf1,
-	0xec, 0x85, 0x98, 0xf8, 0xea, 0x40, 0x4c, 0x3c, 0x39, 0x10, 0xb9, 0xa7, 0x07, 0x22, 0xf7, 0xd7,
-	0x81, 0xc8, 0x3d, 0x7a, 0x29, 0x26, 0x9e, 0xbe, 0x14, 0x13, 0xcf, 0x5e, 0x8a, 0x89, 0x8f, 0x5f,
-	0x63, 0x49, 0xba, 0xd8, 0x6a, 0x3b, 0x28, 0xfc, 0x3b, 0xaa, 0x36, 0x1d, 0xee, 0x95, 0xab, 0xff,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x5b, 0xda, 0x9c, 0x5c, 0x0d, 0x00, 0x00,
+	// 1452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x1a, 0x47,
+	0x1b, 0x66, 0x01, 0x63, 0x33, 0x60, 0x9b, 0x8c, 0x1d, 0x1b, 0xf3, 0xe5, 0xdb, 0xa5, 0xdb, 0xaa,
+	0x8a, 0xd2, 0x18, 0x37, 0x4e, 0x7f, 0xa8, 0xce, 0x89, 0x35, 0xeb, 0x94, 0x28, 0x31, 0x68, 0x21,
+	0x58, 0x69, 0x2b, 0xad, 0x16, 0xef, 0x04, 0xb6, 0xdd, 0xdd, 0xa1, 0xec, 0xe0, 0xc4, 0xea, 0xa5,
+	0xc7, 0x88, 0xaa, 0x6d, 0x8e, 0xb9, 0x20, 0x59, 0xea, 0x2d, 0xe7, 0xfe, 0x07, 0xbd, 0x44, 0x55,
+	0x0f, 0x51, 0xd5, 0x43, 0x54, 0x55, 0xa4, 0x71, 0xa4, 0x2a, 0xf2, 0xd1, 0xd7, 0x5e, 0xaa, 0xdd,
+	0x99, 0x35, 0x0b, 0x58, 0x72, 0xc2, 0xc9, 0xbb, 0xef, 0xbc, 0xcf, 0xf3, 0xbc, 0xf3, 0x30, 0xef,
+	0x3b, 0x6b, 0x70, 0x61, 0x17, 0x3b, 0x16, 0x76, 0xd6, 0x1a, 0x78, 0x6f, 0x6d, 0xef, 0x4a, 0x1d,
+	0x11, 0xed, 0x8a, 0xfb, 0x9c, 0x6b, 0xb5, 0x31, 0xc1, 0x10, 0xd2, 0xd5, 0x9c, 0x1b, 0x61, 0xab,
+	0x19, 0x9e, 0x21, 0xea, 0x9a, 0x83, 0x4e, 0x20, 0xbb, 0xd8, 0xb0, 0x29, 0x26, 0xb3, 0xd8, 0xc0,
+	0x0d, 0xec, 0x3d, 0xae, 0xb9, 0x4f, 0x2c, 0xba, 0x42, 0x51, 0x2a, 0x5d, 0x60, 0xb4, 0x74, 0x49,
+	0x68, 0x60, 0xdc, 0x30, 0xd1, 0x9a, 0xf7, 0x56, 0xef, 0xdc, 0x5d, 0x23, 0x86, 0x85, 0x1c, 0xa2,
+	0x59, 0x2d, 0x1f, 0x3b, 0x9a, 0xa0, 0xd9, 0xfb, 0x6c, 0x89, 0x1f, 0x5d, 0xd2, 0x3b, 0x6d, 0x8d,
+	0x18, 0x98, 0x15, 0x23, 0x3e, 0xe6, 0x00, 0xdc, 0x41, 0x46, 0xa3, 0x49, 0x90, 0x5e, 0xc3, 0x04,
+	0x95, 0x5a, 0xee, 0x22, 0xfc, 0x08, 0xc4, 0xb0, 0xf7, 0x94, 0xe6, 0xb2, 0xdc, 0xc5, 0xb9, 0x75,
+	0x3e, 0x37, 0xbe, 0xd1, 0xdc, 0x20, 0x5f, 0x61, 0xd9, 0xf0, 0x0b, 0x10, 0xbb, 0xe7, 0xb1, 0xa5,
+	0xc3, 0x59, 0xee, 0x62, 0x5c, 0x2a, 0x3c, 0xe9, 0x0b, 0xa1, 0x3f, 0xfb, 0xc2, 0x7b, 0x0d, 0x83,
+	0x34, 0x3b, 0xf5, 0xdc, 0x2e, 0xb6, 0xd6, 0x8c, 0xb6, 0xe1, 0xd8, 0x88, 0x78, 0x7f, 0x9b, 0x9d,
+	0xfa, 0xaa, 0xa3, 0x7f, 0xb5, 0xda, 0xc0, 0x6b, 0x64, 0xbf, 0x85, 0x9c, 0x5c, 0x01, 0xed, 0x1e,
+	0xf7, 0x85, 0xd9, 0x7d, 0xcd, 0x32, 0x37, 0x44, 0x4a, 0x25, 0x2a, 0x8c, 0x53, 0xdc, 0x01, 0xc9,
+	0x2a, 0xba, 0x4f, 0xca, 0x6d, 0xdc, 0xc2, 0x8e, 0x66, 0xc2, 0x45, 0x30, 0x45, 0x0c, 0x62, 0x22,
+	0xaf, 0xc8, 0xb8, 0x42, 0x5f, 0x60, 0x16, 0x24, 0x74, 0xe4, 0xec, 0xb6, 0x0d, 0xba, 0x01, 0xaf,
+	0x10, 0x25, 0x18, 0xda, 0x98, 0x7f, 0x75, 0x20, 0x70, 0xbf, 0xff, 0xbc, 0x3a, 0xbd, 0x89, 0x6d,
+	0x82, 0x6c, 0x22, 0xfe, 0xc1, 0x81, 0xe9, 0x02, 0x6a, 0x61, 0xc7, 0x20, 0xf0, 0x63, 0x90, 0x68,
+	0x31, 0x01, 0xd5, 0xd0, 0x3d, 0xea, 0xa8, 0xb4, 0x74, 0xdc, 0x17, 0x20, 0x2d, 0x2a, 0xb0, 0x28,
+	0x2a, 0xc0, 0x7f, 0x2b, 0xea, 0xf0, 0x02, 0x88, 0xeb, 0x94, 0x03, 0xb7, 0x99, 0xea, 0x20, 0x00,
+	0x9b, 0x20, 0xa6, 0x59, 0xb8, 0x63, 0x93, 0x74, 0x24, 0x1b, 0xb9, 0x98, 0x58, 0x5f, 0xf1, 0x1d,
+	0x75, 0x8f, 0xc9, 0x89, 0xa5, 0x9b, 0xd8, 0xb0, 0xa5, 0x0f, 0x5d, 0xd3, 0x1e, 0x3f, 0x17, 0x56,
+	0x5f, 0xd7, 0x34, 0x17, 0xe5, 0x28, 0x8c, 0x7f, 0x63, 0xe6, 0xc1, 0x81, 0x10, 0x7a, 0x75, 0x20,
+	0x84, 0xc4, 0x7f, 0x63, 0x60, 0xe6, 0xc4, 0xac, 0x0f, 0x4e, 0xdb, 0xd7, 0xc2, 0x51, 0x5f, 0x08,
+	0x1b, 0xfa, 0x71, 0x5f, 0x88, 0xd3, 0xdd, 0x8d, 0x6e, 0xea, 0x1a, 0x98, 0xde, 0xa5, 0x26, 0x79,
+	0x5b, 0x4a, 0xac, 0x2f, 0xe6, 0xe8, 0x89, 0xca, 0xf9, 0x27, 0x2a, 0x97, 0xb7, 0xf7, 0xa5, 0xc4,
+	0xaf, 0x03, 0x37, 0x15, 0x1f, 0x01, 0x6b, 0x20, 0xe6, 0x10, 0x8d, 0x74, 0x9c, 0x74, 0xc4, 0x3b,
+	0x45, 0xe2, 0x69, 0xa7, 0xc8, 0x2f, 0xb0, 0xe2, 0x65, 0x4a, 0x99, 0xe3, 0xbe, 0xb0, 0x34, 0xe2,
+	0x34, 0x25, 0x11, 0x15, 0xc6, 0x06, 0x5b, 0x00, 0xde, 0x35, 0x6c, 0xcd, 0x54, 0x89, 0x66, 0x9a,
+	0xfb, 0x6a, 0x1b, 0x39, 0x1d, 0x93, 0xa4, 0xa3, 0x5e, 0x7d, 0xc2, 0x69, 0x1a, 0x55, 0x37, 0x4f,
+	0xf1, 0xd2, 0xa4, 0xb7, 0x5c, 0x77, 0x8f, 0xfb, 0xc2, 0x0a, 0x15, 0x19, 0x27, 0x12, 0x95, 0x94,
+	0x17, 0x0c, 0x80, 0xe0, 0xe7, 0x20, 0xe1, 0x74, 0xea, 0x96, 0x41, 0x54, 0xb7, 0xf7, 0xd2, 0x53,
+	0x9e, 0x54, 0x66, 0xcc, 0x8a, 0xaa, 0xdf, 0x98, 0x12, 0xcf, 0x54, 0xd8, 0xa1, 0x09, 0x80, 0xc5,
+	0x87, 0xcf, 0x05, 0x4e, 0x01, 0x34, 0xe2, 0x02, 0xa0, 0x01, 0x52, 0xec, 0x9c, 0xa8, 0xc8, 0xd6,
+	0xa9, 0x42, 0xec, 0x4c, 0x85, 0xb7, 0x99, 0xc2, 0x32, 0x55, 0x18, 0x65, 0xa0, 0x32, 0x73, 0x2c,
+	0x2c, 0xdb, 0xba, 0x27, 0xf5, 0x3d, 0x07, 0x66, 0x09, 0x26, 0x9a, 0xa9, 0xb2, 0x85, 0xf4, 0xf4,
+	0x59, 0xa7, 0xf1, 0x16, 0xd3, 0x59, 0xa4, 0x3a, 0x43, 0x68, 0xf1, 0xcd, 0x4f, 0x69, 0xd2, 0x23,
+	0xf0, 0x9b, 0xcd, 0x04, 0xe7, 0xf6, 0x30, 0x31, 0xec, 0x86, 0xfb, 0x1b, 0xb7, 0x99, 0xbb, 0x33,
+	0x67, 0xee, 0xfd, 0x1d, 0x56, 0x53, 0x9a, 0xd6, 0x34, 0x46, 0x41, 0x37, 0x3f, 0x4f, 0xe3, 0x15,
+	0x37, 0xec, 0xed, 0xfe, 0x2e, 0x60, 0xa1, 0x81, 0xcf, 0xf1, 0x33, 0xb5, 0x44, 0xa6, 0xb5, 0x34,
+	0xa4, 0x35, 0x6c, 0xf3, 0x2c, 0x8d, 0x32, 0x97, 0x37, 0xa2, 0xee, 0x7c, 0x11, 0xff, 0x0a, 0x83,
+	0x44, 0xf0, 0x0c, 0xc9, 0x20, 0xb2, 0x8f, 0x1c, 0x3a, 0xab, 0xa4, 0xab, 0x6f, 0x3a, 0x18, 0x8b,
+	0x36, 0x51, 0x5c, 0x3c, 0xbc, 0x05, 0xa6, 0xb5, 0xba, 0x43, 0x34, 0x83, 0x8d, 0xb6, 0xc9, 0xa8,
+	0x7c, 0x0e, 0xb8, 0x09, 0xc2, 0x36, 0x4e, 0x47, 0x26, 0x67, 0x0a, 0xdb, 0x18, 0x9a, 0x20, 0x69,
+	0x63, 0xf5, 0x9e, 0x41, 0x9a, 0xea, 0x1e, 0x22, 0xd8, 0x6b, 0xc5, 0xb8, 0x74, 0x63, 0x02, 0xba,
+	0xe3, 0xbe, 0xb0, 0x40, 0x8d, 0x0e, 0x12, 0x8a, 0x0a, 0xb0, 0xf1, 0x8e, 0x41, 0x9a, 0x35, 0x44,
+	0x30, 0xb3, 0xf7, 0x39, 0x07, 0xa2, 0xee, 0x0d, 0x34, 0xf9, 0xc0, 0x5e, 0x04, 0x53, 0x7b, 0x98,
+	0x20, 0x7f, 0x58, 0xd3, 0x97, 0xc0, 0xd5, 0x17, 0x79, 0xa3, 0xab, 0x6f, 0x0b, 0x4c, 0xd3, 0x27,
+	0x27, 0x1d, 0xf5, 0x7a, 0xea, 0xdd, 0xd3, 0x80, 0xe3, 0x77, 0xad, 0x14, 0x75, 0x6d, 0x52, 0x7c,
+	0xf0, 0xc6, 0xcc, 0x23, 0x7f, 0x7c, 0xff, 0x12, 0x06, 0xb3, 0xac, 0x51, 0xca, 0x5a, 0x5b, 0xb3,
+	0x1c, 0x78, 0xc0, 0x81, 0x84, 0x65, 0xd8, 0x27, 0xcd, 0xcb, 0x9d, 0xd5, 0xbc, 0xba, 0xcb, 0x7d,
+	0xd4, 0x17, 0xce, 0x07, 0x50, 0x97, 0xb1, 0x65, 0x10, 0x64, 0xb5, 0xc8, 0xfe, 0xc0, 0xa3, 0xc0,
+	0xf2, 0x04, 0x3d, 0x0d, 0x2c, 0xc3, 0xf6, 0x3b, 0xfa, 0x07, 0x0e, 0x40, 0x4b, 0xbb, 0xef, 0xb3,
+	0xa9, 0x2d, 0xd4, 0x36, 0xb0, 0xce, 0x2e, 0x8f, 0x95, 0xb1, 0x3e, 0x2b, 0xb0, 0xcf, 0x11, 0x49,
+	0x66, 0x95, 0x5e, 0x18, 0x07, 0x0f, 0x15, 0xcc, 0xc6, 0xf6, 0x78, 0x96, 0xf8, 0xc8, 0xed, 0xc4,
+	0x94, 0xa5, 0xdd, 0xf7, 0x3d, 0xa3, 0xe1, 0xef, 0x38, 0x90, 0xac, 0x79, 0xed, 0xc9, 0x4c, 0xfc,
+	0x06, 0xb0, 0x76, 0xf5, 0x6b, 0xe3, 0xce, 0xaa, 0xed, 0x1a, 0xab, 0x6d, 0x79, 0x08, 0x37, 0x54,
+	0xd6, 0xe2, 0xd0, 0x74, 0x08, 0x56, 0x94, 0xa4, 0x31, 0x56, 0xcd, 0x91, 0x3f, 0x14, 0x58, 0x31,
+	0x2a, 0x88, 0x7d, 0xdd, 0xc1, 0xed, 0x8e, 0xe5, 0x55, 0x91, 0x94, 0xae, 0x4f, 0xf0, 0xc1, 0x74,
+	0xd4, 0x17, 0x52, 0x94, 0x64, 0x50, 0x92, 0xc2, 0x68, 0x61, 0x13, 0xc4, 0x49, 0xb3, 0x8d, 0x9c,
+	0x26, 0x36, 0xe9, 0xaf, 0x90, 0x94, 0x6e, 0x4c, 0xa6, 0xb1, 0x70, 0xc2, 0x13, 0x90, 0x19, 0x90,
+	0xc3, 0x1f, 0x39, 0x30, 0xe7, 0x36, 0xab, 0x3a, 0xd0, 0x8b, 0x78, 0x7a, 0xcd, 0xc9, 0xf4, 0xd2,
+	0xc3, 0x64, 0x43, 0x76, 0x9f, 0x67, 0x76, 0x0f, 0x65, 0x88, 0xca, 0xac, 0x1b, 0xa8, 0xfa, 0xef,
+	0x97, 0xfe, 0xe1, 0x00, 0x08, 0x7c, 0xd4, 0x5e, 0x06, 0xcb, 0xb5, 0x52, 0x55, 0x56, 0x4b, 0xe5,
+	0x6a, 0xb1, 0xb4, 0xad, 0xde, 0xde, 0xae, 0x94, 0xe5, 0xcd, 0xe2, 0x56, 0x51, 0x2e, 0xa4, 0x42,
+	0x99, 0xf9, 0x6e, 0x2f, 0x9b, 0xa0, 0x89, 0xb2, 0x2b, 0x02, 0x45, 0x30, 0x1f, 0xcc, 0xbe, 0x23,
+	0x57, 0x52, 0x5c, 0x66, 0xb6, 0xdb, 0xcb, 0xc6, 0x69, 0xd6, 0x1d, 0xe4, 0xc0, 0x4b, 0x60, 0x21,
+	0x98, 0x93, 0x97, 0x2a, 0xd5, 0x7c, 0x71, 0x3b, 0x15, 0xce, 0x9c, 0xeb, 0xf6, 0xb2, 0xb3, 0x34,
+	0x2f, 0xcf, 0x06, 0x6d, 0x16, 0xcc, 0x05, 0x73, 0xb7, 0x4b, 0xa9, 0x48, 0x26, 0xd9, 0xed, 0x65,
+	0x67, 0x68, 0xda, 0x36, 0x86, 0xeb, 0x20, 0x3d, 0x9c, 0xa1, 0xee, 0x14, 0xab, 0x9f, 0xaa, 0x35,
+	0xb9, 0x5a, 0x4a, 0x45, 0x33, 0x8b, 0xdd, 0x5e, 0x36, 0xe5, 0xe7, 0xfa, 0xb3, 0x30, 0x13, 0x7d,
+	0xf0, 0x13, 0x1f, 0xba, 0xf4, 0x5b, 0x18, 0xcc, 0x0d, 0x7f, 0x47, 0xc1, 0x1c, 0xf8, 0x5f, 0x59,
+	0x29, 0x95, 0x4b, 0x95, 0xfc, 0x4d, 0xb5, 0x52, 0xcd, 0x57, 0x6f, 0x57, 0x46, 0x36, 0xec, 0x6d,
+	0x85, 0x26, 0x6f, 0x1b, 0x26, 0xbc, 0x06, 0xf8, 0xd1, 0xfc, 0x82, 0x5c, 0x2e, 0x55, 0x8a, 0x55,
+	0xb5, 0x2c, 0x2b, 0xc5, 0x52, 0x21, 0xc5, 0x65, 0x96, 0xbb, 0xbd, 0xec, 0x02, 0x85, 0x0c, 0xf5,
+	0x18, 0xfc, 0x04, 0xfc, 0x7f, 0x14, 0x5c, 0x2b, 0x55, 0x8b, 0xdb, 0xd7, 0x7d, 0x6c, 0x38, 0xb3,
+	0xd4, 0xed, 0x65, 0x21, 0xc5, 0xd6, 0x02, 0x0d, 0x01, 0x2f, 0x83, 0xa5, 0x51, 0x68, 0x39, 0x5f,
+	0xa9, 0xc8, 0x85, 0x54, 0x24, 0x93, 0xea, 0xf6, 0xb2, 0x49, 0x8a, 0x29, 0x6b, 0x8e, 0x83, 0x74,
+	0xf8, 0x3e, 0x48, 0x8f, 0x66, 0x2b, 0xf2, 0x0d, 0x79, 0xb3, 0x2a, 0x17, 0x52, 0xd1, 0x0c, 0xec,
+	0xf6, 0xb2, 0x73, 0x34, 0x5f, 0x41, 0x5f, 0xa2, 0x5d, 0x82, 0x4e, 0xe5, 0xdf, 0xca, 0x17, 0x6f,
+	0xca, 0x85, 0xd4, 0x54, 0x90, 0x7f, 0x4b, 0x33, 0x4c, 0xa4, 0x53, 0x3b, 0xa5, 0xca, 0x93, 0x17,
+	0x7c, 0xe8, 0xd9, 0x0b, 0x3e, 0xf4, 0xed, 0x21, 0x1f, 0x7a, 0x72, 0xc8, 0x73, 0x4f, 0x0f, 0x79,
+	0xee, 0xef, 0x43, 0x9e, 0x7b, 0xf8, 0x92, 0x0f, 0x3d, 0x7d, 0xc9, 0x87, 0x9e, 0xbd, 0xe4, 0x43,
+	0x9f, 0xbd, 0xc6, 0x90, 0xb4, 0xb0, 0xde, 0x31, 0x91, 0xf7, 0xcf, 0x63, 0x3d, 0xe6, 0xcd, 0x95,
+	0xab, 0xff, 0x0d, 0x00, 0xfe, 0x6f, 0x98, 0xd3, 0x51, 0x0e, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -667,6 +713,44 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -937,6 +1021,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1097,6 +1195,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1204,6 +1316,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1256,6 +1374,112 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2125,6 +2349,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
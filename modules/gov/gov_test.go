@@ -0,0 +1,27 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryProposalsPageStatus(t *testing.T) {
+	server := &govServer{proposals: map[uint64]Proposal{
+		1: testProposal(t, 1, StatusDepositPeriod, 0, 0, 0),
+		2: testProposal(t, 2, StatusVotingPeriod, 0, 0, 0),
+	}}
+	gc := newTestGovClient(t, server)
+
+	res, err := gc.QueryProposalsPage(QueryProposalsReq{Status: "PROPOSAL_STATUS_VOTING_PERIOD"})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 1)
+	require.Equal(t, uint64(2), res.Proposals[0].ProposalId)
+
+	_, err = gc.QueryProposalsPage(QueryProposalsReq{Status: "VOTING_PERIOD"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid proposal status VOTING_PERIOD")
+
+	_, err = gc.QueryProposals("voting")
+	require.Error(t, err)
+}
@@ -17,6 +17,11 @@ func (v Vote) String() string {
 	return string(out)
 }
 
+func (o WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(o)
+	return string(out)
+}
+
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
 	return string(out)
@@ -9,6 +9,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -207,22 +208,7 @@ func (tp *TextProposal) ProposalType() string { return ProposalTypeText }
 
 // ValidateBasic validates the content's title and description of the proposal
 func (tp *TextProposal) ValidateBasic() error {
-	title := tp.GetTitle()
-	if len(strings.TrimSpace(title)) == 0 {
-		return sdk.Wrapf("proposal title cannot be blank")
-	}
-	if len(title) > MaxTitleLength {
-		return sdk.Wrapf("proposal title is longer than max length of %d", MaxTitleLength)
-	}
-
-	description := tp.GetDescription()
-	if len(description) == 0 {
-		return sdk.Wrapf("proposal description cannot be blank")
-	}
-	if len(description) > MaxDescriptionLength {
-		return sdk.Wrapf("proposal description is longer than max length of %d", MaxDescriptionLength)
-	}
-	return nil
+	return ValidateAbstract(tp)
 }
 
 // String implements Stringer interface
@@ -232,7 +218,20 @@ func (tp TextProposal) String() string {
 }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:                  {},
+	ProposalTypeParameterChange:       {},
+	ProposalTypeSoftwareUpgrade:       {},
+	ProposalTypeCancelSoftwareUpgrade: {},
+	ProposalTypeCommunityPoolSpend:    {},
+}
+
+// contents are the prototypes of the content types registered as implementations of Content
+var contents = []Content{
+	&TextProposal{},
+	&ParameterChangeProposal{},
+	&SoftwareUpgradeProposal{},
+	&CancelSoftwareUpgradeProposal{},
+	&distribution.CommunityPoolSpendProposal{},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -245,12 +244,29 @@ func RegisterProposalType(ty string) {
 	validProposalTypes[ty] = struct{}{}
 }
 
-// ContentFromProposalType returns a Content object based on the proposal type.
+// RegisterProposalContent registers a custom content type, so that its proposals can be submitted
+// with SubmitContentProposal and decoded by the queries. The content must be a proto message.
+// It must be called before the client is created, e.g. in an init function, and will panic if
+// the proposal type is already registered.
+func RegisterProposalContent(content Content) {
+	if _, ok := content.(proto.Message); !ok {
+		panic(fmt.Sprintf("%T does not implement proto.Message", content))
+	}
+
+	RegisterProposalType(content.ProposalType())
+	contents = append(contents, content)
+}
+
+// ContentFromProposalType returns a Content object based on the proposal type,
+// nil if the content of the type has more fields than the title and the description.
 func ContentFromProposalType(title, desc, ty string) Content {
 	switch ty {
 	case ProposalTypeText:
 		return NewTextProposal(title, desc)
 
+	case ProposalTypeCancelSoftwareUpgrade:
+		return NewCancelSoftwareUpgradeProposal(title, desc)
+
 	default:
 		return nil
 	}
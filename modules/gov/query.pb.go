@@ -839,68 +839,68 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 973 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
 	0x14, 0xf6, 0x24, 0x4e, 0x6b, 0xbf, 0xb4, 0x01, 0x1e, 0x01, 0xac, 0x25, 0xd8, 0x61, 0x45, 0x5b,
-	0x93, 0x12, 0x2f, 0x49, 0x0a, 0xa8, 0x2d, 0xa0, 0x12, 0xa1, 0xa6, 0xa8, 0x12, 0x2a, 0x4e, 0x05,
-	0x12, 0x07, 0xa2, 0x75, 0xbd, 0xda, 0xae, 0x70, 0x76, 0xb6, 0x9e, 0xb1, 0xa5, 0x28, 0x44, 0x48,
-	0x9c, 0x40, 0x5c, 0x40, 0x45, 0xdc, 0x10, 0x95, 0x2a, 0xf1, 0x5b, 0x7a, 0xac, 0x04, 0x07, 0x4e,
-	0x08, 0x25, 0x1c, 0x10, 0xbf, 0x81, 0x03, 0xda, 0xd9, 0x37, 0xeb, 0x5d, 0x67, 0x9d, 0xdd, 0x94,
-	0xaa, 0xa7, 0xd8, 0x33, 0xdf, 0x7b, 0xef, 0xfb, 0xde, 0x9b, 0xf9, 0x26, 0x86, 0xfa, 0x2d, 0x2e,
-	0xb6, 0xb9, 0xb0, 0x5c, 0x3e, 0xb4, 0x86, 0x2b, 0x1d, 0x47, 0xda, 0x2b, 0xd6, 0x9d, 0x81, 0xd3,
-	0xdf, 0x69, 0x05, 0x7d, 0x2e, 0x39, 0x62, 0xb4, 0xdf, 0x72, 0xf9, 0xb0, 0x45, 0xfb, 0xc6, 0x12,
-	0xc5, 0x74, 0x6c, 0xe1, 0x44, 0xe0, 0x38, 0x34, 0xb0, 0x5d, 0xcf, 0xb7, 0xa5, 0xc7, 0xfd, 0x28,
-	0xde, 0x98, 0x77, 0xb9, 0xcb, 0xd5, 0x47, 0x2b, 0xfc, 0x44, 0xab, 0x0b, 0x2e, 0xe7, 0x6e, 0xcf,
-	0xb1, 0xec, 0xc0, 0xb3, 0x6c, 0xdf, 0xe7, 0x52, 0x85, 0x08, 0xbd, 0x9b, 0xc1, 0x29, 0xac, 0xaf,
-	0x76, 0xcd, 0xb7, 0x60, 0xfe, 0xa3, 0xb0, 0xe6, 0x8d, 0x3e, 0x0f, 0xb8, 0xb0, 0x7b, 0x6d, 0xe7,
-	0xce, 0xc0, 0x11, 0x12, 0x1b, 0x30, 0x1b, 0xd0, 0xd2, 0x96, 0xd7, 0xad, 0xb1, 0x45, 0xd6, 0x2c,
-	0xb7, 0x41, 0x2f, 0x7d, 0xd0, 0x35, 0x3f, 0x81, 0xe7, 0xc6, 0x02, 0x45, 0xc0, 0x7d, 0xe1, 0xe0,
-	0xbb, 0x50, 0xd1, 0x30, 0x15, 0x36, 0xbb, 0xba, 0xd0, 0x3a, 0x2c, 0xbb, 0xa5, 0xe3, 0xd6, 0xcb,
-	0x0f, 0xfe, 0x68, 0x94, 0xda, 0x71, 0x8c, 0xf9, 0x0f, 0x1b, 0xcb, 0x2c, 0x34, 0xa7, 0xeb, 0xf0,
-	0x54, 0xcc, 0x49, 0x48, 0x5b, 0x0e, 0x84, 0x2a, 0x30, 0xb7, 0x6a, 0x1e, 0x55, 0x60, 0x53, 0x21,
-	0xdb, 0x73, 0x41, 0xea, 0x3b, 0xce, 0xc3, 0xcc, 0x90, 0x4b, 0xa7, 0x5f, 0x9b, 0x5a, 0x64, 0xcd,
-	0x6a, 0x3b, 0xfa, 0x82, 0x0b, 0x50, 0xed, 0x3a, 0x01, 0x17, 0x9e, 0xe4, 0xfd, 0xda, 0xb4, 0xda,
-	0x19, 0x2d, 0xe0, 0x55, 0x80, 0xd1, 0x48, 0x6a, 0x65, 0x25, 0xee, 0xac, 0xae, 0x1d, 0xce, 0xaf,
-	0x15, 0x0d, 0x3b, 0xa6, 0x60, 0xbb, 0x0e, 0x91, 0x6f, 0x27, 0x22, 0x2f, 0x55, 0xbe, 0xbe, 0xd7,
-	0x28, 0xfd, 0x7d, 0xaf, 0x51, 0x32, 0xef, 0x33, 0x78, 0x7e, 0x5c, 0x2c, 0xf5, 0xf1, 0x0a, 0x54,
-	0x35, 0xe5, 0x50, 0xe7, 0x74, 0xc1, 0x46, 0x8e, 0x82, 0x70, 0x23, 0x45, 0x77, 0x4a, 0xd1, 0x3d,
-	0x97, 0x4b, 0x37, 0x2a, 0x9f, 0xe4, 0x6b, 0x6e, 0xc2, 0xd3, 0x8a, 0xe4, 0xc7, 0x5c, 0x3a, 0x45,
-	0x0f, 0x48, 0x76, 0x83, 0x13, 0xd2, 0x37, 0xe0, 0x99, 0x44, 0x52, 0x12, 0xbd, 0x0a, 0xe5, 0x10,
-	0x47, 0x07, 0xa7, 0x96, 0xa5, 0x37, 0xc4, 0x93, 0x56, 0x85, 0x35, 0xbf, 0x48, 0x24, 0x12, 0x85,
-	0xe9, 0x5d, 0xcd, 0x68, 0xce, 0x23, 0xcc, 0xd2, 0xbc, 0xcb, 0x00, 0x93, 0xe5, 0x49, 0xc8, 0x85,
-	0x48, 0xbd, 0x9e, 0x5c, 0x9e, 0x92, 0x08, 0xfc, 0xf8, 0x26, 0xf6, 0x06, 0x91, 0xba, 0x61, 0xf7,
-	0xed, 0xed, 0x54, 0x53, 0xd4, 0xc2, 0x96, 0xdc, 0x09, 0xa2, 0x26, 0x57, 0xc3, 0xb0, 0x70, 0xe9,
-	0xe6, 0x4e, 0xe0, 0x98, 0xff, 0x32, 0x78, 0x36, 0x15, 0x47, 0x6a, 0xae, 0xc3, 0xe9, 0x21, 0x97,
-	0x9e, 0xef, 0x6e, 0x45, 0x60, 0x9a, 0xcf, 0xe2, 0x04, 0x55, 0x9e, 0xef, 0x46, 0x09, 0x48, 0xdd,
-	0xa9, 0x61, 0x62, 0x0d, 0x3f, 0x84, 0x39, 0xba, 0x52, 0x3a, 0x5b, 0x24, 0xf4, 0xe5, 0xac, 0x6c,
-	0xef, 0x47, 0xc8, 0x54, 0xba, 0xd3, 0xdd, 0xe4, 0x22, 0x5e, 0x83, 0x53, 0xd2, 0xee, 0xf5, 0x76,
-	0x74, 0xb6, 0x69, 0x95, 0xad, 0x91, 0x95, 0xed, 0x66, 0x88, 0x4b, 0xe5, 0x9a, 0x95, 0xa3, 0x25,
-	0xf3, 0x33, 0x52, 0x4f, 0x45, 0x0b, 0x9f, 0xa5, 0x94, 0x6b, 0x4c, 0x8d, 0xb9, 0x46, 0xe2, 0xc8,
-	0x6f, 0x92, 0xd9, 0xc6, 0xf9, 0xa9, 0xbd, 0x97, 0xe1, 0x24, 0xc1, 0xa9, 0xb1, 0x2f, 0x1e, 0xd1,
-	0x0a, 0x22, 0xae, 0x23, 0xcc, 0x2f, 0xd3, 0x49, 0x9f, 0xfc, 0x0d, 0xf8, 0x59, 0x1b, 0xf6, 0x88,
-	0x01, 0xe9, 0x7a, 0x07, 0x2a, 0xc4, 0x52, 0xdf, 0x83, 0x02, 0xc2, 0xe2, 0x90, 0xc7, 0x77, 0x1b,
-	0x2e, 0xc1, 0x0b, 0x8a, 0xa0, 0x1a, 0x7f, 0xdb, 0x11, 0x83, 0x9e, 0x3c, 0xc6, 0x3b, 0x57, 0x3b,
-	0x1c, 0x1b, 0xcf, 0x6d, 0x46, 0x1d, 0x1f, 0x9a, 0xda, 0xe4, 0x23, 0x17, 0xc5, 0xe9, 0xbb, 0xae,
-	0x62, 0x56, 0x7f, 0xab, 0xc2, 0x8c, 0xca, 0x8c, 0x3f, 0x30, 0xa8, 0x68, 0x17, 0xc7, 0x66, 0x56,
-	0x92, 0xac, 0x27, 0xda, 0x78, 0xb5, 0x00, 0x32, 0x22, 0x6a, 0xae, 0x7d, 0xf5, 0xeb, 0x5f, 0x77,
-	0xa7, 0x96, 0xf1, 0xbc, 0x95, 0xf1, 0xcf, 0x40, 0xfc, 0x60, 0x58, 0xbb, 0x89, 0x56, 0xec, 0xe1,
-	0x37, 0x0c, 0xaa, 0xf1, 0xb3, 0x84, 0xf9, 0xd5, 0xf4, 0xc9, 0x33, 0x96, 0x8a, 0x40, 0x89, 0xd9,
-	0x19, 0xc5, 0xac, 0x81, 0x2f, 0x1d, 0xc9, 0x0c, 0x7f, 0x64, 0x50, 0x0e, 0xed, 0x12, 0x5f, 0x99,
-	0x98, 0x3b, 0xf1, 0x38, 0x19, 0x67, 0x72, 0x50, 0x54, 0xfc, 0x3d, 0x55, 0xfc, 0x32, 0x5e, 0x3c,
-	0x46, 0x5b, 0x2c, 0xe5, 0xd4, 0xd6, 0xae, 0x7a, 0xce, 0xf6, 0xf0, 0x7b, 0x06, 0x33, 0xca, 0xf9,
-	0xf1, 0xe8, 0x9a, 0x71, 0x73, 0xce, 0xe6, 0xc1, 0x88, 0xdb, 0x45, 0xc5, 0x6d, 0x0d, 0x57, 0x8e,
-	0xcd, 0x0d, 0xbf, 0x65, 0x70, 0x82, 0xbc, 0x71, 0x72, 0xb5, 0xd4, 0xcb, 0x60, 0x9c, 0xcb, 0xc5,
-	0x11, 0xad, 0xd7, 0x15, 0xad, 0x25, 0x6c, 0x66, 0xd2, 0x52, 0x58, 0x6b, 0x37, 0xf1, 0xc8, 0xec,
-	0xe1, 0x2f, 0x0c, 0x4e, 0xd2, 0x0d, 0xc7, 0xc9, 0x65, 0xd2, 0x96, 0x6b, 0x34, 0xf3, 0x81, 0x44,
-	0xe8, 0x9a, 0x22, 0xb4, 0x8e, 0x57, 0x8e, 0xd3, 0x27, 0x6d, 0x31, 0xd6, 0x6e, 0x6c, 0xd3, 0x7b,
-	0xf8, 0x13, 0x83, 0x8a, 0xb6, 0x30, 0xcc, 0x25, 0x20, 0xf2, 0xaf, 0xe1, 0xb8, 0x1f, 0x9a, 0x6f,
-	0x2b, 0xae, 0x6f, 0xe2, 0x85, 0x47, 0xe1, 0x8a, 0xf7, 0x19, 0xcc, 0x26, 0xdc, 0x04, 0xcf, 0x4f,
-	0x2c, 0x7c, 0xd8, 0xe7, 0x8c, 0xd7, 0x8a, 0x81, 0xff, 0xcf, 0xe1, 0x53, 0xb6, 0xb6, 0xbe, 0xf1,
-	0x60, 0xbf, 0xce, 0x1e, 0xee, 0xd7, 0xd9, 0x9f, 0xfb, 0x75, 0xf6, 0xdd, 0x41, 0xbd, 0xf4, 0xf0,
-	0xa0, 0x5e, 0xfa, 0xfd, 0xa0, 0x5e, 0xfa, 0x74, 0xd9, 0xf5, 0xe4, 0xed, 0x41, 0xa7, 0x75, 0x8b,
-	0x6f, 0x5b, 0x5e, 0xdf, 0x13, 0xbe, 0x23, 0xd5, 0xdf, 0xdb, 0x83, 0xce, 0xb2, 0xe8, 0x7e, 0xbe,
-	0xec, 0x72, 0x6b, 0x9b, 0x77, 0x07, 0x3d, 0x47, 0x95, 0xeb, 0x9c, 0x50, 0x3f, 0x50, 0xd6, 0xfe,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xea, 0x9d, 0xde, 0x54, 0x0d, 0x00, 0x00,
+	0x93, 0x12, 0x2f, 0x49, 0x0a, 0xa8, 0x2d, 0xa0, 0x12, 0xa1, 0xa6, 0xa8, 0x12, 0x2a, 0x9b, 0x0a,
+	0x24, 0x0e, 0x44, 0xeb, 0x7a, 0xb5, 0x5d, 0xe1, 0xec, 0x6c, 0x77, 0xc6, 0x96, 0xa2, 0x10, 0x21,
+	0x71, 0x02, 0x71, 0x01, 0x15, 0x71, 0x43, 0x54, 0xaa, 0xc4, 0xdf, 0xd2, 0x63, 0x25, 0x38, 0x70,
+	0x42, 0x28, 0xe1, 0x80, 0xf8, 0x1b, 0x38, 0xa0, 0x9d, 0x1f, 0xeb, 0x5d, 0x67, 0x9d, 0xdd, 0x94,
+	0xaa, 0x27, 0xdb, 0x33, 0xdf, 0xfb, 0xde, 0xf7, 0xbd, 0x37, 0xf3, 0x26, 0x81, 0xe6, 0x2d, 0xca,
+	0xb6, 0x29, 0xb3, 0x3c, 0x3a, 0xb4, 0x86, 0x2b, 0x5d, 0x97, 0x3b, 0x2b, 0xd6, 0x9d, 0x81, 0x1b,
+	0xed, 0x74, 0xc2, 0x88, 0x72, 0x8a, 0x28, 0xf7, 0x3b, 0x1e, 0x1d, 0x76, 0xd4, 0xbe, 0xb1, 0xa4,
+	0x62, 0xba, 0x0e, 0x73, 0x25, 0x38, 0x09, 0x0d, 0x1d, 0xcf, 0x0f, 0x1c, 0xee, 0xd3, 0x40, 0xc6,
+	0x1b, 0xf3, 0x1e, 0xf5, 0xa8, 0xf8, 0x6a, 0xc5, 0xdf, 0xd4, 0xea, 0x82, 0x47, 0xa9, 0xd7, 0x77,
+	0x2d, 0x27, 0xf4, 0x2d, 0x27, 0x08, 0x28, 0x17, 0x21, 0x4c, 0xef, 0xe6, 0x68, 0x8a, 0xf3, 0x8b,
+	0x5d, 0xf3, 0x2d, 0x98, 0xff, 0x28, 0xce, 0x79, 0x23, 0xa2, 0x21, 0x65, 0x4e, 0xdf, 0x76, 0xef,
+	0x0c, 0x5c, 0xc6, 0xb1, 0x05, 0xb3, 0xa1, 0x5a, 0xda, 0xf2, 0x7b, 0x0d, 0xb2, 0x48, 0xda, 0x55,
+	0x1b, 0xf4, 0xd2, 0x07, 0x3d, 0xf3, 0x13, 0x78, 0x6e, 0x2c, 0x90, 0x85, 0x34, 0x60, 0x2e, 0xbe,
+	0x0b, 0x35, 0x0d, 0x13, 0x61, 0xb3, 0xab, 0x0b, 0x9d, 0xc3, 0xb6, 0x3b, 0x3a, 0x6e, 0xbd, 0xfa,
+	0xe0, 0x8f, 0x56, 0xc5, 0x4e, 0x62, 0xcc, 0x7f, 0xc8, 0x18, 0x33, 0xd3, 0x9a, 0xae, 0xc3, 0x53,
+	0x89, 0x26, 0xc6, 0x1d, 0x3e, 0x60, 0x22, 0xc1, 0xdc, 0xaa, 0x79, 0x54, 0x82, 0x4d, 0x81, 0xb4,
+	0xe7, 0xc2, 0xcc, 0x6f, 0x9c, 0x87, 0x99, 0x21, 0xe5, 0x6e, 0xd4, 0x98, 0x5a, 0x24, 0xed, 0xba,
+	0x2d, 0x7f, 0xe0, 0x02, 0xd4, 0x7b, 0x6e, 0x48, 0x99, 0xcf, 0x69, 0xd4, 0x98, 0x16, 0x3b, 0xa3,
+	0x05, 0xbc, 0x0a, 0x30, 0x6a, 0x49, 0xa3, 0x2a, 0xcc, 0x9d, 0xd5, 0xb9, 0xe3, 0xfe, 0x75, 0x64,
+	0xb3, 0x13, 0x09, 0x8e, 0xe7, 0x2a, 0xf1, 0x76, 0x2a, 0xf2, 0x52, 0xed, 0xeb, 0x7b, 0xad, 0xca,
+	0xdf, 0xf7, 0x5a, 0x15, 0xf3, 0x3e, 0x81, 0xe7, 0xc7, 0xcd, 0xaa, 0x3a, 0x5e, 0x81, 0xba, 0x96,
+	0x1c, 0xfb, 0x9c, 0x2e, 0x59, 0xc8, 0x51, 0x10, 0x6e, 0x64, 0xe4, 0x4e, 0x09, 0xb9, 0xe7, 0x0a,
+	0xe5, 0xca, 0xf4, 0x69, 0xbd, 0xe6, 0x26, 0x3c, 0x2d, 0x44, 0x7e, 0x4c, 0xb9, 0x5b, 0xf6, 0x80,
+	0xe4, 0x17, 0x38, 0x65, 0x7d, 0x03, 0x9e, 0x49, 0x91, 0x2a, 0xd3, 0xab, 0x50, 0x8d, 0x71, 0xea,
+	0xe0, 0x34, 0xf2, 0xfc, 0xc6, 0x78, 0xe5, 0x55, 0x60, 0xcd, 0x2f, 0x52, 0x44, 0xac, 0xb4, 0xbc,
+	0xab, 0x39, 0xc5, 0x79, 0x84, 0x5e, 0x9a, 0x77, 0x09, 0x60, 0x3a, 0xbd, 0x32, 0x72, 0x41, 0xba,
+	0xd7, 0x9d, 0x2b, 0x72, 0x22, 0xc1, 0x8f, 0xaf, 0x63, 0x6f, 0x28, 0x51, 0x37, 0x9c, 0xc8, 0xd9,
+	0xce, 0x14, 0x45, 0x2c, 0x6c, 0xf1, 0x9d, 0x50, 0x16, 0xb9, 0x6e, 0x83, 0x5c, 0xba, 0xb9, 0x13,
+	0xba, 0xe6, 0xbf, 0x04, 0x9e, 0xcd, 0xc4, 0x29, 0x37, 0xd7, 0xe1, 0xf4, 0x90, 0x72, 0x3f, 0xf0,
+	0xb6, 0x24, 0x58, 0xf5, 0x67, 0x71, 0x82, 0x2b, 0x3f, 0xf0, 0x24, 0x81, 0x72, 0x77, 0x6a, 0x98,
+	0x5a, 0xc3, 0x0f, 0x61, 0x4e, 0x5d, 0x29, 0xcd, 0x26, 0x8d, 0xbe, 0x9c, 0xc7, 0xf6, 0xbe, 0x44,
+	0x66, 0xe8, 0x4e, 0xf7, 0xd2, 0x8b, 0x78, 0x0d, 0x4e, 0x71, 0xa7, 0xdf, 0xdf, 0xd1, 0x6c, 0xd3,
+	0x82, 0xad, 0x95, 0xc7, 0x76, 0x33, 0xc6, 0x65, 0xb8, 0x66, 0xf9, 0x68, 0xc9, 0xfc, 0x4c, 0xb9,
+	0x57, 0x49, 0x4b, 0x9f, 0xa5, 0xcc, 0xd4, 0x98, 0x1a, 0x9b, 0x1a, 0xa9, 0x23, 0xbf, 0x09, 0xf3,
+	0x59, 0x7e, 0x55, 0xde, 0xcb, 0x70, 0x52, 0xc1, 0x55, 0x61, 0x5f, 0x3c, 0xa2, 0x14, 0x4a, 0xb8,
+	0x8e, 0x30, 0xbf, 0xcc, 0x92, 0x3e, 0xf9, 0x1b, 0xf0, 0xb3, 0x1e, 0xd8, 0x23, 0x05, 0xca, 0xd7,
+	0x3b, 0x50, 0x53, 0x2a, 0xf5, 0x3d, 0x28, 0x61, 0x2c, 0x09, 0x79, 0x7c, 0xb7, 0xe1, 0x12, 0xbc,
+	0x20, 0x04, 0x8a, 0xf6, 0xdb, 0x2e, 0x1b, 0xf4, 0xf9, 0x31, 0xde, 0xb9, 0xc6, 0xe1, 0xd8, 0xa4,
+	0x6f, 0x33, 0xe2, 0xf8, 0x34, 0x48, 0xc1, 0x91, 0x93, 0x71, 0xfa, 0xae, 0x8b, 0x98, 0xd5, 0xdf,
+	0xea, 0x30, 0x23, 0x98, 0xf1, 0x07, 0x02, 0x35, 0x3d, 0xc5, 0xb1, 0x9d, 0x47, 0x92, 0xf7, 0x44,
+	0x1b, 0xaf, 0x96, 0x40, 0x4a, 0xa1, 0xe6, 0xda, 0x57, 0xbf, 0xfe, 0x75, 0x77, 0x6a, 0x19, 0xcf,
+	0x5b, 0x39, 0x7f, 0x0c, 0x24, 0x0f, 0x86, 0xb5, 0x9b, 0x2a, 0xc5, 0x1e, 0x7e, 0x43, 0xa0, 0xae,
+	0x99, 0x18, 0x16, 0x67, 0xd3, 0x27, 0xcf, 0x58, 0x2a, 0x03, 0x55, 0xca, 0xce, 0x08, 0x65, 0x2d,
+	0x7c, 0xe9, 0x48, 0x65, 0xf8, 0x23, 0x81, 0x6a, 0x3c, 0x2e, 0xf1, 0x95, 0x89, 0xdc, 0xa9, 0xc7,
+	0xc9, 0x38, 0x53, 0x80, 0x52, 0xc9, 0xdf, 0x13, 0xc9, 0x2f, 0xe3, 0xc5, 0x63, 0x94, 0xc5, 0x12,
+	0x93, 0xda, 0xda, 0x8d, 0x3f, 0xa2, 0x3d, 0xfc, 0x9e, 0xc0, 0x4c, 0xcc, 0xc9, 0xf0, 0xe8, 0x9c,
+	0x49, 0x71, 0xce, 0x16, 0xc1, 0x94, 0xb6, 0x8b, 0x42, 0xdb, 0x1a, 0xae, 0x1c, 0x5b, 0x1b, 0x7e,
+	0x4b, 0xe0, 0x84, 0x9a, 0x8d, 0x93, 0xb3, 0x65, 0x5e, 0x06, 0xe3, 0x5c, 0x21, 0x4e, 0xc9, 0x7a,
+	0x5d, 0xc8, 0x5a, 0xc2, 0x76, 0xae, 0x2c, 0x81, 0xb5, 0x76, 0x53, 0x8f, 0xcc, 0x1e, 0xfe, 0x42,
+	0xe0, 0xa4, 0xba, 0xe1, 0x38, 0x39, 0x4d, 0x76, 0xe4, 0x1a, 0xed, 0x62, 0xa0, 0x12, 0x74, 0x4d,
+	0x08, 0x5a, 0xc7, 0x2b, 0xc7, 0xa9, 0x93, 0x1e, 0x31, 0xd6, 0x6e, 0x32, 0xa6, 0xf7, 0xf0, 0x27,
+	0x02, 0x35, 0xc5, 0xce, 0xb0, 0x50, 0x00, 0x2b, 0xbe, 0x86, 0xe3, 0xf3, 0xd0, 0x7c, 0x5b, 0x68,
+	0x7d, 0x13, 0x2f, 0x3c, 0x8a, 0x56, 0xbc, 0x4f, 0x60, 0x36, 0x35, 0x4d, 0xf0, 0xfc, 0xc4, 0xc4,
+	0x87, 0xe7, 0x9c, 0xf1, 0x5a, 0x39, 0xf0, 0xff, 0x39, 0x7c, 0x62, 0xac, 0xad, 0x6f, 0x3c, 0xd8,
+	0x6f, 0x92, 0x87, 0xfb, 0x4d, 0xf2, 0xe7, 0x7e, 0x93, 0x7c, 0x77, 0xd0, 0xac, 0x3c, 0x3c, 0x68,
+	0x56, 0x7e, 0x3f, 0x68, 0x56, 0x3e, 0x5d, 0xf6, 0x7c, 0x7e, 0x7b, 0xd0, 0xed, 0xdc, 0xa2, 0xdb,
+	0x96, 0x1f, 0xf9, 0x2c, 0x70, 0xb9, 0xf8, 0xbc, 0x3d, 0xe8, 0x2e, 0xb3, 0xde, 0xe7, 0xcb, 0x1e,
+	0xb5, 0xb6, 0x69, 0x6f, 0xd0, 0x77, 0x45, 0xba, 0xee, 0x09, 0xf1, 0x0f, 0xca, 0xda, 0x7f, 0x03,
+	0x00, 0x1e, 0xea, 0x9d, 0xde, 0x54, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -193,6 +193,82 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote, split across several options.
+type MsgVoteWeighted struct {
+	ProposalId uint64               `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      string               `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Options    []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c053992595e3dce, []int{4}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
+// MsgVoteWeightedResponse defines the Msg/VoteWeighted response type.
+type MsgVoteWeightedResponse struct {
+}
+
+func (m *MsgVoteWeightedResponse) Reset()         { *m = MsgVoteWeightedResponse{} }
+func (m *MsgVoteWeightedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeightedResponse) ProtoMessage()    {}
+func (*MsgVoteWeightedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c053992595e3dce, []int{5}
+}
+func (m *MsgVoteWeightedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeightedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeightedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeightedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeightedResponse.Merge(m, src)
+}
+func (m *MsgVoteWeightedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeightedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
 type MsgDeposit struct {
 	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -203,7 +279,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c053992595e3dce, []int{4}
+	return fileDescriptor_3c053992595e3dce, []int{6}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +316,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c053992595e3dce, []int{5}
+	return fileDescriptor_3c053992595e3dce, []int{7}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,6 +350,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1beta1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.gov.v1beta1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.gov.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos.gov.v1beta1.MsgVoteWeighted")
+	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.gov.v1beta1.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1beta1.MsgDepositResponse")
 }
@@ -281,45 +359,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/tx.proto", fileDescriptor_3c053992595e3dce) }
 
 var fileDescriptor_3c053992595e3dce = []byte{
-	// 600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x93, 0xd2, 0xd0, 0x8b, 0xd4, 0xd2, 0x53, 0x84, 0x52, 0xb7, 0xb2, 0x23, 0xa3, 0xa2,
-	0x2e, 0xb1, 0xd5, 0x20, 0x18, 0xca, 0x44, 0x8a, 0xf8, 0x31, 0x44, 0x05, 0x23, 0x31, 0xb0, 0x54,
-	0x76, 0x72, 0xbd, 0x9e, 0x88, 0xfd, 0xac, 0xdc, 0x39, 0x22, 0x1b, 0x23, 0x62, 0x42, 0x82, 0x81,
-	0xb1, 0x33, 0x1b, 0x12, 0x7f, 0x44, 0xc5, 0xd4, 0x91, 0x01, 0x05, 0xd4, 0x2c, 0x88, 0x81, 0xa1,
-	0x7f, 0x01, 0xf2, 0x9d, 0x9d, 0x56, 0x4d, 0x5a, 0x8a, 0xd4, 0x29, 0x79, 0xef, 0x7b, 0xdf, 0xa7,
-	0xfb, 0xbe, 0x7b, 0x3e, 0xb4, 0xdc, 0x06, 0x1e, 0x02, 0x77, 0x29, 0xf4, 0xdd, 0xfe, 0x7a, 0x40,
-	0x84, 0xbf, 0xee, 0x8a, 0x57, 0x4e, 0xdc, 0x03, 0x01, 0x18, 0x2b, 0xd0, 0xa1, 0xd0, 0x77, 0x32,
-	0xd0, 0x30, 0x33, 0x42, 0xe0, 0x73, 0x32, 0x66, 0xb4, 0x81, 0x45, 0x8a, 0x63, 0xac, 0x4c, 0x11,
-	0x4c, 0xf9, 0x0a, 0x5d, 0x52, 0xe8, 0xb6, 0xac, 0xdc, 0x4c, 0x5e, 0x41, 0x15, 0x0a, 0x14, 0x54,
-	0x3f, 0xfd, 0x97, 0x13, 0x28, 0x00, 0xed, 0x12, 0x57, 0x56, 0x41, 0xb2, 0xe3, 0xfa, 0xd1, 0x40,
-	0x41, 0xf6, 0x87, 0x02, 0x5a, 0x6c, 0x71, 0xfa, 0x2c, 0x09, 0x42, 0x26, 0x9e, 0xf4, 0x20, 0x06,
-	0xee, 0x77, 0xf1, 0x5d, 0x54, 0x6a, 0x43, 0x24, 0x48, 0x24, 0xaa, 0x7a, 0x4d, 0x5f, 0x2b, 0x37,
-	0x2a, 0x8e, 0x92, 0x70, 0x72, 0x09, 0xe7, 0x5e, 0x34, 0x68, 0x96, 0xbf, 0x7e, 0xa9, 0x97, 0x36,
-	0xd5, 0xa0, 0x97, 0x33, 0xf0, 0x7b, 0x1d, 0x2d, 0xb0, 0x88, 0x09, 0xe6, 0x77, 0xb7, 0x3b, 0x24,
-	0x06, 0xce, 0x44, 0xb5, 0x50, 0x2b, 0xae, 0x95, 0x1b, 0x4b, 0x4e, 0x76, 0xd8, 0xd4, 0x77, 0x1e,
-	0x86, 0xb3, 0x09, 0x2c, 0x6a, 0x6e, 0xed, 0x0f, 0x2d, 0xed, 0x68, 0x68, 0x5d, 0x1f, 0xf8, 0x61,
-	0x77, 0xc3, 0x3e, 0xc5, 0xb7, 0x3f, 0xfd, 0xb0, 0xea, 0x94, 0x89, 0xdd, 0x24, 0x70, 0xda, 0x10,
-	0xba, 0xac, 0xc7, 0x78, 0x44, 0x84, 0xfc, 0xdd, 0x4d, 0x82, 0x3a, 0xef, 0xbc, 0xac, 0x53, 0x70,
-	0xc5, 0x20, 0x26, 0x5c, 0xea, 0x71, 0x6f, 0x3e, 0x93, 0xb8, 0xaf, 0x14, 0xb0, 0x81, 0xae, 0xc6,
-	0xd2, 0x1e, 0xe9, 0x55, 0x8b, 0x35, 0x7d, 0x6d, 0xce, 0x1b, 0xd7, 0x1b, 0xd7, 0xde, 0xec, 0x59,
-	0xda, 0xc7, 0x3d, 0x4b, 0xfb, 0xb5, 0x67, 0x69, 0xaf, 0xbf, 0xd7, 0x34, 0xbb, 0x8d, 0x96, 0x26,
-	0x52, 0xf1, 0x08, 0x8f, 0x21, 0xe2, 0x04, 0x3f, 0x40, 0xe5, 0x38, 0xeb, 0x6d, 0xb3, 0x8e, 0x4c,
-	0x68, 0xa6, 0xb9, 0xfa, 0x7b, 0x68, 0x9d, 0x6c, 0x1f, 0x0d, 0x2d, 0xac, 0xbc, 0x9c, 0x68, 0xda,
-	0x1e, 0xca, 0xab, 0xc7, 0x1d, 0xfb, 0xb3, 0x8e, 0x4a, 0x2d, 0x4e, 0x9f, 0x83, 0xb8, 0x34, 0x4d,
-	0x5c, 0x41, 0x57, 0xfa, 0x20, 0x48, 0xaf, 0x5a, 0x90, 0x1e, 0x55, 0x81, 0xef, 0xa0, 0x59, 0x88,
-	0x05, 0x83, 0x48, 0x5a, 0x9f, 0x6f, 0x98, 0xce, 0xe4, 0x52, 0x3a, 0xe9, 0x39, 0xb6, 0xe4, 0x94,
-	0x97, 0x4d, 0x4f, 0x09, 0x66, 0x11, 0x2d, 0x64, 0x47, 0xce, 0xe3, 0xb0, 0xff, 0xe8, 0x08, 0xb5,
-	0x38, 0xcd, 0x83, 0xbe, 0x2c, 0x27, 0x2b, 0x68, 0x2e, 0xbb, 0x7d, 0xc8, 0xdd, 0x1c, 0x37, 0xf0,
-	0x2e, 0x9a, 0xf5, 0x43, 0x48, 0x22, 0x51, 0x2d, 0xfe, 0x6b, 0xb5, 0x6e, 0xa7, 0xab, 0xf5, 0xff,
-	0x0b, 0x94, 0xe9, 0x4f, 0xc9, 0xa0, 0x82, 0xf0, 0xb1, 0xdf, 0x3c, 0x86, 0xc6, 0xdb, 0x02, 0x2a,
-	0xb6, 0x38, 0xc5, 0x3b, 0x68, 0xfe, 0xd4, 0xd7, 0xb4, 0x3a, 0x2d, 0xed, 0x89, 0xf5, 0x32, 0xea,
-	0x17, 0x1a, 0x1b, 0x6f, 0xe1, 0x23, 0x34, 0x23, 0x37, 0x67, 0xf9, 0x0c, 0x5a, 0x0a, 0x1a, 0x37,
-	0xce, 0x01, 0xc7, 0x4a, 0x4f, 0x51, 0x29, 0xbf, 0x3c, 0xf3, 0x8c, 0xf9, 0x0c, 0x37, 0x6e, 0x9e,
-	0x8f, 0xe7, 0x92, 0xcd, 0x87, 0xfb, 0x87, 0xa6, 0x7e, 0x70, 0x68, 0xea, 0x3f, 0x0f, 0x4d, 0xfd,
-	0xdd, 0xc8, 0xd4, 0x0e, 0x46, 0xa6, 0xf6, 0x6d, 0x64, 0x6a, 0x2f, 0x2e, 0x70, 0x0b, 0x21, 0x74,
-	0x92, 0x2e, 0x91, 0xaf, 0x5f, 0x30, 0x2b, 0x1f, 0x9c, 0x5b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xdd, 0x15, 0xe5, 0x99, 0x63, 0x05, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x93, 0xd2, 0xd0, 0x0b, 0x6a, 0xa9, 0x15, 0x41, 0xe2, 0x56, 0x76, 0x64, 0xd4, 0xaa,
+	0x12, 0x8a, 0xad, 0x06, 0xc1, 0x50, 0x26, 0x52, 0x54, 0x60, 0x88, 0x0a, 0x46, 0x02, 0x89, 0xa5,
+	0xd8, 0xc9, 0xd5, 0x39, 0x91, 0xf8, 0x59, 0xb9, 0x4b, 0x44, 0x36, 0x46, 0x46, 0x24, 0x18, 0x18,
+	0x3b, 0xb3, 0x21, 0xf1, 0x23, 0x0a, 0x53, 0x47, 0x06, 0x14, 0x50, 0xbb, 0x20, 0x84, 0x18, 0xfa,
+	0x0b, 0x90, 0xef, 0x7c, 0x6e, 0x69, 0xd3, 0x12, 0xa4, 0x32, 0x25, 0xef, 0x7d, 0xef, 0xfb, 0xf2,
+	0xbe, 0xe7, 0xf7, 0x1c, 0x34, 0xd7, 0x00, 0xda, 0x01, 0xea, 0x04, 0xd0, 0x77, 0xfa, 0xcb, 0x3e,
+	0x66, 0xde, 0xb2, 0xc3, 0x9e, 0xdb, 0x51, 0x17, 0x18, 0x68, 0x9a, 0x00, 0xed, 0x00, 0xfa, 0x76,
+	0x02, 0xea, 0x46, 0x42, 0xf0, 0x3d, 0x8a, 0x53, 0x46, 0x03, 0x48, 0x28, 0x38, 0xfa, 0xfc, 0x08,
+	0xc1, 0x98, 0x2f, 0xd0, 0x92, 0x40, 0x37, 0x78, 0xe4, 0x24, 0xf2, 0x02, 0x2a, 0x04, 0x10, 0x80,
+	0xc8, 0xc7, 0xdf, 0x24, 0x21, 0x00, 0x08, 0xda, 0xd8, 0xe1, 0x91, 0xdf, 0xdb, 0x74, 0xbc, 0x70,
+	0x20, 0x20, 0xeb, 0x4d, 0x06, 0xcd, 0xd6, 0x69, 0xf0, 0xb0, 0xe7, 0x77, 0x08, 0xbb, 0xdf, 0x85,
+	0x08, 0xa8, 0xd7, 0xd6, 0x6e, 0xa2, 0x5c, 0x03, 0x42, 0x86, 0x43, 0x56, 0x54, 0xcb, 0xea, 0x52,
+	0xbe, 0x5a, 0xb0, 0x85, 0x84, 0x2d, 0x25, 0xec, 0x5b, 0xe1, 0xa0, 0x96, 0xff, 0xf4, 0xa1, 0x92,
+	0x5b, 0x15, 0x85, 0xae, 0x64, 0x68, 0xaf, 0x55, 0x34, 0x43, 0x42, 0xc2, 0x88, 0xd7, 0xde, 0x68,
+	0xe2, 0x08, 0x28, 0x61, 0xc5, 0x4c, 0x39, 0xbb, 0x94, 0xaf, 0x96, 0xec, 0xa4, 0xd9, 0xd8, 0xb7,
+	0x1c, 0x86, 0xbd, 0x0a, 0x24, 0xac, 0xad, 0x6f, 0x0f, 0x4d, 0x65, 0x7f, 0x68, 0x5e, 0x1a, 0x78,
+	0x9d, 0xf6, 0x8a, 0x75, 0x84, 0x6f, 0xbd, 0xfb, 0x6a, 0x56, 0x02, 0xc2, 0x5a, 0x3d, 0xdf, 0x6e,
+	0x40, 0xc7, 0x21, 0x5d, 0x42, 0x43, 0xcc, 0xf8, 0x67, 0xab, 0xe7, 0x57, 0x68, 0xf3, 0x59, 0x25,
+	0x00, 0x87, 0x0d, 0x22, 0x4c, 0xb9, 0x1e, 0x75, 0xa7, 0x13, 0x89, 0xdb, 0x42, 0x41, 0xd3, 0xd1,
+	0xf9, 0x88, 0xdb, 0xc3, 0xdd, 0x62, 0xb6, 0xac, 0x2e, 0x4d, 0xb9, 0x69, 0xbc, 0x72, 0xf1, 0xe5,
+	0x96, 0xa9, 0xbc, 0xdd, 0x32, 0x95, 0xef, 0x5b, 0xa6, 0xf2, 0xe2, 0x4b, 0x59, 0xb1, 0x1a, 0xa8,
+	0x74, 0x6c, 0x2a, 0x2e, 0xa6, 0x11, 0x84, 0x14, 0x6b, 0x6b, 0x28, 0x1f, 0x25, 0xb9, 0x0d, 0xd2,
+	0xe4, 0x13, 0x9a, 0xa8, 0x2d, 0xfc, 0x18, 0x9a, 0x87, 0xd3, 0xfb, 0x43, 0x53, 0x13, 0x5e, 0x0e,
+	0x25, 0x2d, 0x17, 0xc9, 0xe8, 0x5e, 0xd3, 0x7a, 0xaf, 0xa2, 0x5c, 0x9d, 0x06, 0x8f, 0x80, 0x9d,
+	0x99, 0xa6, 0x56, 0x40, 0xe7, 0xfa, 0xc0, 0x70, 0xb7, 0x98, 0xe1, 0x1e, 0x45, 0xa0, 0xdd, 0x40,
+	0x93, 0x10, 0x31, 0x02, 0x21, 0xb7, 0x3e, 0x5d, 0x35, 0xec, 0xe3, 0x4b, 0x69, 0xc7, 0x7d, 0xac,
+	0xf3, 0x2a, 0x37, 0xa9, 0x1e, 0x31, 0x98, 0x59, 0x34, 0x93, 0xb4, 0x2c, 0xc7, 0x61, 0x7d, 0x54,
+	0xd3, 0xdc, 0x63, 0x4c, 0x82, 0x16, 0xc3, 0xcd, 0xff, 0x6c, 0x67, 0x0d, 0xe5, 0x44, 0x83, 0xb4,
+	0x98, 0xe5, 0x8b, 0xb5, 0x38, 0xca, 0x8f, 0x6c, 0xe6, 0xc0, 0x57, 0x6d, 0x22, 0xde, 0x32, 0x57,
+	0x92, 0x47, 0xd8, 0x2b, 0xa1, 0xcb, 0x47, 0xac, 0xa4, 0x36, 0x7f, 0xa9, 0x08, 0xd5, 0x69, 0x20,
+	0xf7, 0xe9, 0xac, 0x1c, 0xce, 0xa3, 0xa9, 0x64, 0xc9, 0x41, 0xba, 0x3c, 0x48, 0x68, 0x2d, 0x34,
+	0xe9, 0x75, 0xa0, 0x17, 0xb2, 0x62, 0xf6, 0x6f, 0x17, 0x74, 0x3d, 0xf6, 0xf6, 0xef, 0x77, 0x92,
+	0xe8, 0x8f, 0x98, 0x45, 0x01, 0x69, 0x07, 0x7e, 0xe5, 0x18, 0xaa, 0x3f, 0x33, 0x28, 0x5b, 0xa7,
+	0x81, 0xb6, 0x89, 0xa6, 0x8f, 0xbc, 0x34, 0x16, 0x46, 0x3d, 0x84, 0x63, 0x57, 0xa4, 0x57, 0xc6,
+	0x2a, 0x4b, 0x8f, 0xed, 0x2e, 0x9a, 0xe0, 0x07, 0x32, 0x77, 0x02, 0x2d, 0x06, 0xf5, 0x2b, 0xa7,
+	0x80, 0xa9, 0xd2, 0x53, 0x74, 0xe1, 0x8f, 0x1d, 0x3d, 0x8d, 0x24, 0x8b, 0xf4, 0xab, 0x63, 0x14,
+	0xa5, 0xbf, 0xf0, 0x00, 0xe5, 0xe4, 0x7a, 0x18, 0x27, 0xf0, 0x12, 0x5c, 0x5f, 0x3c, 0x1d, 0x97,
+	0x92, 0xb5, 0x3b, 0xdb, 0xbb, 0x86, 0xba, 0xb3, 0x6b, 0xa8, 0xdf, 0x76, 0x0d, 0xf5, 0xd5, 0x9e,
+	0xa1, 0xec, 0xec, 0x19, 0xca, 0xe7, 0x3d, 0x43, 0x79, 0x32, 0xc6, 0x73, 0xee, 0x40, 0xb3, 0xd7,
+	0xc6, 0xfc, 0x6f, 0xc4, 0x9f, 0xe4, 0x6f, 0xee, 0x6b, 0xbf, 0x07, 0x00, 0x42, 0x33, 0x9e, 0x01,
+	0xac, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -338,6 +420,8 @@ type MsgClient interface {
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method to add a vote on a specific proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
+	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 }
@@ -368,6 +452,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error) {
+	out := new(MsgVoteWeightedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Msg/VoteWeighted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Msg/Deposit", in, out, opts...)
@@ -383,6 +476,8 @@ type MsgServer interface {
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method to add a vote on a specific proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
+	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 }
@@ -397,6 +492,9 @@ func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
+}
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -441,6 +539,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteWeighted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteWeighted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Msg/VoteWeighted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteWeighted(ctx, req.(*MsgVoteWeighted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -471,6 +587,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "VoteWeighted",
+			Handler:    _Msg_VoteWeighted_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
@@ -627,6 +747,78 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeightedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeightedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -773,6 +965,37 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteWeightedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1213,6 +1436,197 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,7 @@ var (
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgVoteWeighted{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -199,9 +200,71 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{voter}
 }
 
+func (msg MsgVoteWeighted) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return "weighted_vote" }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter == "" {
+		return sdk.Wrapf("missing voter")
+	}
+
+	if len(msg.Options) == 0 {
+		return sdk.Wrapf("missing vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range msg.Options {
+		if !ValidWeightedVoteOption(option) {
+			return sdk.Wrapf("invalid vote option %s", option.String())
+		}
+		if usedOptions[option.Option] {
+			return sdk.Wrapf("duplicated vote option %s", option.Option.String())
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdk.Wrapf("total weight of the vote options must be 1, got %s", totalWeight.String())
+	}
+
+	return nil
+}
+
+// ValidWeightedVoteOption returns true if the option is valid and its weight is in (0, 1]
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
 func (q Proposal) Convert() interface{} {
 	return QueryProposalResp{
 		ProposalId: q.ProposalId,
+		Content:    q.GetContent(),
 		Status:     ProposalStatus_name[int32(q.Status)],
 		FinalTallyResult: QueryTallyResultResp{
 			Yes:        q.FinalTallyResult.Yes,
@@ -232,6 +295,7 @@ func (v Vote) Convert() interface{} {
 		ProposalId: v.ProposalId,
 		Voter:      v.Voter,
 		Option:     int32(v.Option),
+		Options:    v.Options,
 	}
 }
 
@@ -270,6 +334,36 @@ func (d Deposit) Convert() interface{} {
 	}
 }
 
+func (q QueryProposalsResponse) Convert() interface{} {
+	res := QueryProposalsResp{
+		Proposals: Proposals(q.Proposals).Convert().([]QueryProposalResp),
+	}
+	if q.Pagination != nil {
+		res.Total = q.Pagination.Total
+	}
+	return res
+}
+
+func (q QueryVotesResponse) Convert() interface{} {
+	res := QueryVotesResp{
+		Votes: Votes(q.Votes).Convert().([]QueryVoteResp),
+	}
+	if q.Pagination != nil {
+		res.Total = q.Pagination.Total
+	}
+	return res
+}
+
+func (q QueryDepositsResponse) Convert() interface{} {
+	res := QueryDepositsResp{
+		Deposits: Deposits(q.Deposits).Convert().([]QueryDepositResp),
+	}
+	if q.Pagination != nil {
+		res.Total = q.Pagination.Total
+	}
+	return res
+}
+
 type Deposits []Deposit
 
 func (ds Deposits) Convert() interface{} {
@@ -288,3 +382,49 @@ func (t TallyResult) Convert() interface{} {
 		NoWithVeto: t.NoWithVeto,
 	}
 }
+
+// newLiveTally computes the outcome of the tally against the bonded tokens and the tally params, like the gov module
+// does at the end of the voting period: the quorum fails the proposal, then no votes but abstain or the veto
+// threshold reject it, and the yes votes above the threshold pass it
+func newLiveTally(tally QueryTallyResultResp, bondedTokens sdk.Int, params tallyParams) QueryLiveTallyResp {
+	res := QueryLiveTallyResp{
+		Tally:         tally,
+		BondedTokens:  bondedTokens,
+		Turnout:       sdk.ZeroDec(),
+		YesRatio:      sdk.ZeroDec(),
+		VetoRatio:     sdk.ZeroDec(),
+		Quorum:        params.Quorum,
+		Threshold:     params.Threshold,
+		VetoThreshold: params.VetoThreshold,
+	}
+
+	total := sdk.ZeroInt()
+	for _, votes := range []sdk.Int{tally.Yes, tally.Abstain, tally.No, tally.NoWithVeto} {
+		if !votes.IsNil() {
+			total = total.Add(votes)
+		}
+	}
+	if total.IsZero() || bondedTokens.IsNil() || !bondedTokens.IsPositive() {
+		return res
+	}
+
+	res.Turnout = sdk.NewDecFromInt(total).Quo(sdk.NewDecFromInt(bondedTokens))
+	res.QuorumReached = res.Turnout.GTE(params.Quorum)
+
+	if !tally.NoWithVeto.IsNil() {
+		res.VetoRatio = sdk.NewDecFromInt(tally.NoWithVeto).Quo(sdk.NewDecFromInt(total))
+	}
+	res.Vetoed = res.VetoRatio.GT(params.VetoThreshold)
+
+	nonAbstain := total
+	if !tally.Abstain.IsNil() {
+		nonAbstain = total.Sub(tally.Abstain)
+	}
+	if nonAbstain.IsPositive() && !tally.Yes.IsNil() {
+		res.YesRatio = sdk.NewDecFromInt(tally.Yes).Quo(sdk.NewDecFromInt(nonAbstain))
+		res.ThresholdReached = res.YesRatio.GT(params.Threshold)
+	}
+
+	res.Passing = res.QuorumReached && !res.Vetoed && res.ThresholdReached
+	return res
+}
@@ -0,0 +1,114 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestNewLiveTally(t *testing.T) {
+	params := tallyParams{
+		Quorum:        sdk.NewDecWithPrec(334, 3),
+		Threshold:     sdk.NewDecWithPrec(5, 1),
+		VetoThreshold: sdk.NewDecWithPrec(334, 3),
+	}
+	tally := func(yes, abstain, no, veto int64) QueryTallyResultResp {
+		return QueryTallyResultResp{
+			Yes:        sdk.NewInt(yes),
+			Abstain:    sdk.NewInt(abstain),
+			No:         sdk.NewInt(no),
+			NoWithVeto: sdk.NewInt(veto),
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		tally   QueryTallyResultResp
+		bonded  sdk.Int
+		turnout sdk.Dec
+		yes     sdk.Dec
+		veto    sdk.Dec
+		quorum  bool
+		vetoed  bool
+		passing bool
+	}{
+		{
+			name:    "passing",
+			tally:   tally(60, 10, 20, 10),
+			bonded:  sdk.NewInt(200),
+			turnout: sdk.NewDecWithPrec(5, 1),
+			yes:     sdk.NewDecWithPrec(666666666666666667, 18),
+			veto:    sdk.NewDecWithPrec(1, 1),
+			quorum:  true,
+			passing: true,
+		},
+		{
+			name:    "quorum not reached",
+			tally:   tally(60, 0, 0, 0),
+			bonded:  sdk.NewInt(1000),
+			turnout: sdk.NewDecWithPrec(6, 2),
+			yes:     sdk.OneDec(),
+			veto:    sdk.ZeroDec(),
+		},
+		{
+			// the abstain votes count in the quorum, but leave no vote to reach the threshold
+			name:    "all abstain",
+			tally:   tally(0, 100, 0, 0),
+			bonded:  sdk.NewInt(100),
+			turnout: sdk.OneDec(),
+			yes:     sdk.ZeroDec(),
+			veto:    sdk.ZeroDec(),
+			quorum:  true,
+		},
+		{
+			name:    "zero bonded tokens",
+			tally:   tally(60, 0, 0, 0),
+			bonded:  sdk.ZeroInt(),
+			turnout: sdk.ZeroDec(),
+			yes:     sdk.ZeroDec(),
+			veto:    sdk.ZeroDec(),
+		},
+		{
+			name:    "veto above the threshold",
+			tally:   tally(60, 0, 0, 40),
+			bonded:  sdk.NewInt(100),
+			turnout: sdk.OneDec(),
+			yes:     sdk.NewDecWithPrec(6, 1),
+			veto:    sdk.NewDecWithPrec(4, 1),
+			quorum:  true,
+			vetoed:  true,
+		},
+		{
+			name:    "veto at the threshold",
+			tally:   tally(666, 0, 0, 334),
+			bonded:  sdk.NewInt(1000),
+			turnout: sdk.OneDec(),
+			yes:     sdk.NewDecWithPrec(666, 3),
+			veto:    sdk.NewDecWithPrec(334, 3),
+			quorum:  true,
+			passing: true,
+		},
+		{
+			name:    "no vote",
+			tally:   tally(0, 0, 0, 0),
+			bonded:  sdk.NewInt(100),
+			turnout: sdk.ZeroDec(),
+			yes:     sdk.ZeroDec(),
+			veto:    sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := newLiveTally(tc.tally, tc.bonded, params)
+			require.Equal(t, tc.turnout.String(), res.Turnout.String())
+			require.Equal(t, tc.yes.String(), res.YesRatio.String())
+			require.Equal(t, tc.veto.String(), res.VetoRatio.String())
+			require.Equal(t, tc.quorum, res.QuorumReached)
+			require.Equal(t, tc.vetoed, res.Vetoed)
+			require.Equal(t, tc.passing, res.Passing)
+		})
+	}
+}
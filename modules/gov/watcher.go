@@ -0,0 +1,322 @@
+package gov
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	eventTypeSubmitProposal   = "submit_proposal"
+	eventTypeProposalDeposit  = "proposal_deposit"
+	eventTypeActiveProposal   = "active_proposal"
+	eventTypeInactiveProposal = "inactive_proposal"
+
+	attributeKeyVotingPeriodStart = "voting_period_start"
+	attributeKeyProposalResult    = "proposal_result"
+
+	attributeValueProposalDropped  = "proposal_dropped"
+	attributeValueProposalPassed   = "proposal_passed"
+	attributeValueProposalRejected = "proposal_rejected"
+	attributeValueProposalFailed   = "proposal_failed"
+)
+
+// ProposalEventType is the type of a ProposalEvent
+type ProposalEventType string
+
+const (
+	ProposalSubmitted     ProposalEventType = "submitted"
+	ProposalVotingStarted ProposalEventType = "voting_started"
+	ProposalVoted         ProposalEventType = "voted"
+	ProposalPassed        ProposalEventType = "passed"
+	ProposalRejected      ProposalEventType = "rejected"
+	ProposalVetoed        ProposalEventType = "vetoed"
+	ProposalFailed        ProposalEventType = "failed"
+	ProposalDropped       ProposalEventType = "dropped"
+)
+
+// ProposalEvent is a change of a proposal notified by WatchProposals
+type ProposalEvent struct {
+	Type       ProposalEventType `json:"type"`
+	ProposalId uint64            `json:"proposal_id"`
+	// height of the block of the change, 0 for the proposals already in the deposit or the voting period
+	// when a watch without FromHeight starts
+	Height int64 `json:"height"`
+	// hash of the tx of the change, empty for the changes of the end block
+	TxHash string `json:"tx_hash"`
+	// the proposal queried when the change is notified, empty for a vote or a dropped proposal
+	Proposal QueryProposalResp `json:"proposal"`
+	// voter and option of a vote, the options of a weighted vote are in Options
+	Voter   string               `json:"voter"`
+	Option  string               `json:"option"`
+	Options []WeightedVoteOption `json:"options"`
+	// watched voters who have not voted yet, when the voting period starts
+	PendingVoters []string `json:"pending_voters"`
+}
+
+// ProposalEventHandler handles the events of WatchProposals
+type ProposalEventHandler func(event ProposalEvent)
+
+// WatchOptions are the options of WatchProposals
+type WatchOptions struct {
+	// addresses whose votes are notified, they are reported as pending voters when the voting period starts
+	Voters []string
+	// height of the first block watched, the blocks from it to the latest one are fetched by
+	// StreamBlocks before the new blocks, and the proposals are only notified from the blocks.
+	// The next block by default
+	FromHeight int64
+	// options of streaming the blocks
+	Stream sdk.StreamBlocksOptions
+}
+
+// WatchProposals follows the blocks and notifies the submitted proposals, the starts of the voting periods,
+// the votes of the watched voters, and the outcomes of the proposals from the end block events. Without
+// FromHeight, it first notifies the proposals already in the deposit period as submitted and the proposals
+// already in the voting period as started, with a past FromHeight they are notified once from the blocks
+// replayed instead. It returns when ctx is done or the blocks can not be fetched.
+func (gc govClient) WatchProposals(ctx context.Context, opts WatchOptions, handler ProposalEventHandler) error {
+	for _, voter := range opts.Voters {
+		if _, err := sdk.AccAddressFromBech32(voter); err != nil {
+			return sdk.Wrap(err)
+		}
+	}
+
+	from := opts.FromHeight
+	if from <= 0 {
+		status, err := gc.Status(ctx)
+		if err != nil {
+			return sdk.Wrap(err)
+		}
+		from = status.SyncInfo.LatestBlockHeight + 1
+
+		if err := gc.notifyOpenProposals(opts.Voters, handler); err != nil {
+			return err
+		}
+	}
+
+	watcher := proposalWatcher{govClient: gc, opts: opts, handler: handler}
+	return gc.StreamBlocks(ctx, from, 0, opts.Stream, func(block sdk.BlockDetail) error {
+		watcher.handleBlock(block)
+		return nil
+	})
+}
+
+// notifyOpenProposals notifies the proposals in the deposit period as submitted and the proposals in
+// the voting period as started, at the latest state
+func (gc govClient) notifyOpenProposals(voters []string, handler ProposalEventHandler) sdk.Error {
+	deposits, err := gc.QueryProposals(ProposalStatus_name[int32(StatusDepositPeriod)])
+	if err != nil {
+		return err
+	}
+	for _, proposal := range deposits {
+		handler(ProposalEvent{Type: ProposalSubmitted, ProposalId: proposal.ProposalId, Proposal: proposal})
+	}
+
+	votings, err := gc.QueryProposals(ProposalStatus_name[int32(StatusVotingPeriod)])
+	if err != nil {
+		return err
+	}
+	for _, proposal := range votings {
+		handler(ProposalEvent{
+			Type:          ProposalVotingStarted,
+			ProposalId:    proposal.ProposalId,
+			Proposal:      proposal,
+			PendingVoters: gc.pendingVoters(proposal.ProposalId, voters),
+		})
+	}
+	return nil
+}
+
+// pendingVoters returns the voters who have not voted on the proposal
+func (gc govClient) pendingVoters(proposalId uint64, voters []string) []string {
+	var pending []string
+	for _, voter := range voters {
+		if _, err := gc.QueryVote(proposalId, voter); err != nil {
+			pending = append(pending, voter)
+		}
+	}
+	return pending
+}
+
+type proposalWatcher struct {
+	govClient
+	opts    WatchOptions
+	handler ProposalEventHandler
+}
+
+func (w proposalWatcher) handleBlock(block sdk.BlockDetail) {
+	height := block.Block.Height
+	results := block.BlockResult.Results
+
+	for i, tx := range block.Block.DecodedTxs {
+		if tx == nil || i >= len(results.DeliverTx) || results.DeliverTx[i].Code != 0 {
+			continue
+		}
+
+		var hash string
+		if i < len(block.Block.Hashes) {
+			hash = block.Block.Hashes[i]
+		}
+
+		events := results.DeliverTx[i].Events
+		for _, id := range attributeValues(events, AttributeKeyProposalId, eventTypeSubmitProposal) {
+			w.notify(ProposalEvent{Type: ProposalSubmitted, ProposalId: id, Height: height, TxHash: hash})
+		}
+		for _, id := range attributeValues(events, attributeKeyVotingPeriodStart, eventTypeSubmitProposal, eventTypeProposalDeposit) {
+			w.notify(ProposalEvent{
+				Type:          ProposalVotingStarted,
+				ProposalId:    id,
+				Height:        height,
+				TxHash:        hash,
+				PendingVoters: w.opts.Voters,
+			})
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			w.notifyVotes(msg, height, hash)
+		}
+	}
+
+	// the attributes of the events of the same type are merged, every proposal id is followed by its result
+	for _, event := range results.EndBlock.Events {
+		if event.Type != eventTypeActiveProposal && event.Type != eventTypeInactiveProposal {
+			continue
+		}
+
+		var id uint64
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case AttributeKeyProposalId:
+				id, _ = strconv.ParseUint(attr.Value, 10, 64)
+			case attributeKeyProposalResult:
+				w.notifyResult(id, attr.Value, height)
+			}
+		}
+	}
+}
+
+// notifyVotes notifies the votes of the watched voters in msg, including the votes executed by authz
+func (w proposalWatcher) notifyVotes(msg sdk.Msg, height int64, hash string) {
+	switch msg := msg.(type) {
+	case *MsgVote:
+		if w.watched(msg.Voter) {
+			w.handler(ProposalEvent{
+				Type:       ProposalVoted,
+				ProposalId: msg.ProposalId,
+				Height:     height,
+				TxHash:     hash,
+				Voter:      msg.Voter,
+				Option:     msg.Option.String(),
+			})
+		}
+	case *MsgVoteWeighted:
+		if w.watched(msg.Voter) {
+			w.handler(ProposalEvent{
+				Type:       ProposalVoted,
+				ProposalId: msg.ProposalId,
+				Height:     height,
+				TxHash:     hash,
+				Voter:      msg.Voter,
+				Options:    msg.Options,
+			})
+		}
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil {
+			w.Logger().Error("unpack the msgs of exec failed", "hash", hash, "errMsg", err.Error())
+			return
+		}
+		for _, m := range msgs {
+			w.notifyVotes(m, height, hash)
+		}
+	}
+}
+
+// notify notifies the event with the current state of the proposal
+func (w proposalWatcher) notify(event ProposalEvent) {
+	proposal, err := w.QueryProposal(event.ProposalId)
+	if err != nil {
+		w.Logger().Error("query proposal failed", "proposalId", event.ProposalId, "errMsg", err.Error())
+	}
+	event.Proposal = proposal
+	w.handler(event)
+}
+
+func (w proposalWatcher) notifyResult(id uint64, result string, height int64) {
+	event := ProposalEvent{ProposalId: id, Height: height}
+	switch result {
+	case attributeValueProposalPassed:
+		event.Type = ProposalPassed
+	case attributeValueProposalFailed:
+		event.Type = ProposalFailed
+	case attributeValueProposalRejected:
+		event.Type = ProposalRejected
+	case attributeValueProposalDropped:
+		// the proposal is deleted with its deposits
+		event.Type = ProposalDropped
+		w.handler(event)
+		return
+	default:
+		return
+	}
+
+	proposal, err := w.QueryProposal(id)
+	if err != nil {
+		w.Logger().Error("query proposal failed", "proposalId", id, "errMsg", err.Error())
+	}
+	event.Proposal = proposal
+
+	if event.Type == ProposalRejected && err == nil && w.vetoed(proposal.FinalTallyResult) {
+		event.Type = ProposalVetoed
+	}
+	w.handler(event)
+}
+
+// vetoed returns true if the share of the no with veto votes exceeds the veto threshold
+func (w proposalWatcher) vetoed(tally QueryTallyResultResp) bool {
+	if tally.NoWithVeto.IsNil() || tally.NoWithVeto.IsZero() {
+		return false
+	}
+
+	params, err := w.QueryParams("tallying")
+	if err != nil {
+		w.Logger().Error("query tally params failed", "errMsg", err.Error())
+		return false
+	}
+
+	total := tally.Yes.Add(tally.Abstain).Add(tally.No).Add(tally.NoWithVeto)
+	share := sdk.NewDecFromInt(tally.NoWithVeto).Quo(sdk.NewDecFromInt(total))
+	return share.GT(params.TallyParams.VetoThreshold)
+}
+
+func (w proposalWatcher) watched(voter string) bool {
+	for _, v := range w.opts.Voters {
+		if v == voter {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeValues returns the proposal ids in the values of the attribute key of the events of the given types
+func attributeValues(events sdk.StringEvents, key string, types ...string) []uint64 {
+	var ids []uint64
+	for _, event := range events {
+		for _, typ := range types {
+			if event.Type != typ {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key != key {
+					continue
+				}
+				if id, err := strconv.ParseUint(attr.Value, 10, 64); err == nil {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,340 @@
+package gov
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________").String()
+	bob   = sdk.AccAddress("bob_________________").String()
+)
+
+// govServer fakes the gov queries of a node
+type govServer struct {
+	UnimplementedQueryServer
+
+	mu        sync.Mutex
+	proposals map[uint64]Proposal
+	// voters by proposal id
+	votes map[uint64][]string
+}
+
+func (s *govServer) Proposal(_ context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	proposal, ok := s.proposals[req.ProposalId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	return &QueryProposalResponse{Proposal: proposal}, nil
+}
+
+func (s *govServer) Proposals(_ context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var proposals []Proposal
+	for id := uint64(1); id <= uint64(len(s.proposals)); id++ {
+		if proposal, ok := s.proposals[id]; ok && proposal.Status == req.ProposalStatus {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return &QueryProposalsResponse{Proposals: proposals}, nil
+}
+
+func (s *govServer) Vote(_ context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, voter := range s.votes[req.ProposalId] {
+		if voter == req.Voter {
+			return &QueryVoteResponse{Vote: Vote{ProposalId: req.ProposalId, Voter: voter, Option: OptionYes}}, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "voter: %s not found for proposal: %d", req.Voter, req.ProposalId)
+}
+
+func (s *govServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return &QueryParamsResponse{TallyParams: TallyParams{
+		Quorum:        sdk.NewDecWithPrec(334, 3),
+		Threshold:     sdk.NewDecWithPrec(5, 1),
+		VetoThreshold: sdk.NewDecWithPrec(334, 3),
+	}}, nil
+}
+
+// testClient serves the gov queries with a govServer and a chain of blocks up to latest, the other methods
+// of sdk.BaseClient are not implemented
+type testClient struct {
+	sdk.BaseClient
+	addr   string
+	latest int64
+	blocks []sdk.BlockDetail
+}
+
+func (c testClient) GenConn() (*grpc.ClientConn, error) {
+	return grpc.Dial(c.addr, grpc.WithInsecure())
+}
+
+func (c testClient) Logger() log.Logger {
+	return log.NewNopLogger()
+}
+
+func (c testClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: c.latest}}, nil
+}
+
+// StreamBlocks streams the blocks from the height from, then waits for ctx to be done
+func (c testClient) StreamBlocks(ctx context.Context, from, to int64, opts sdk.StreamBlocksOptions, handler sdk.BlockHandler) error {
+	for _, block := range c.blocks {
+		if block.Block.Height < from {
+			continue
+		}
+		if err := handler(block); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func newTestGovClient(t *testing.T, server *govServer, blocks ...sdk.BlockDetail) govClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	RegisterQueryServer(s, server)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	registry := cdctypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	var latest int64
+	if len(blocks) > 0 {
+		latest = blocks[len(blocks)-1].Block.Height
+	}
+	return govClient{
+		BaseClient: testClient{addr: lis.Addr().String(), latest: latest, blocks: blocks},
+		Marshaler:  codec.NewProtoCodec(registry),
+	}
+}
+
+// testProposal returns a proposal with the status and the final tally of yes, no and no with veto votes
+func testProposal(t *testing.T, id uint64, status ProposalStatus, yes, no, veto int64) Proposal {
+	proposal, err := NewProposal(NewTextProposal(fmt.Sprintf("proposal %d", id), "text"), id, time.Unix(0, 0), time.Unix(0, 0))
+	require.NoError(t, err)
+	proposal.Status = status
+	proposal.FinalTallyResult = TallyResult{
+		Yes:        sdk.NewInt(yes),
+		Abstain:    sdk.ZeroInt(),
+		No:         sdk.NewInt(no),
+		NoWithVeto: sdk.NewInt(veto),
+	}
+	return proposal
+}
+
+// testTx returns a decoded tx of msgs and its result with the events
+func testTx(code uint32, events sdk.StringEvents, msgs ...sdk.Msg) (sdk.Tx, sdk.TxResult) {
+	return &sdk.DynamicTx{Msgs: msgs}, sdk.TxResult{Code: code, Events: events}
+}
+
+func event(typ string, attrs ...string) sdk.StringEvent {
+	e := sdk.StringEvent{Type: typ}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		e.Attributes = append(e.Attributes, sdk.Attribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return e
+}
+
+// summary keeps the fields of the events compared by the tests
+type summary struct {
+	Type       ProposalEventType
+	ProposalId uint64
+	TxHash     string
+	Status     string
+	Voter      string
+	Option     string
+	Options    []WeightedVoteOption
+	Pending    []string
+}
+
+func summarize(events []ProposalEvent) []summary {
+	var res []summary
+	for _, e := range events {
+		res = append(res, summary{
+			Type:       e.Type,
+			ProposalId: e.ProposalId,
+			TxHash:     e.TxHash,
+			Status:     e.Proposal.Status,
+			Voter:      e.Voter,
+			Option:     e.Option,
+			Options:    e.Options,
+			Pending:    e.PendingVoters,
+		})
+	}
+	return res
+}
+
+func TestHandleBlock(t *testing.T) {
+	server := &govServer{proposals: map[uint64]Proposal{
+		1: testProposal(t, 1, StatusDepositPeriod, 0, 0, 0),
+		2: testProposal(t, 2, StatusVotingPeriod, 0, 0, 0),
+		3: testProposal(t, 3, StatusPassed, 10, 0, 0),
+		4: testProposal(t, 4, StatusRejected, 10, 5, 20),
+		5: testProposal(t, 5, StatusRejected, 10, 20, 5),
+	}}
+	gc := newTestGovClient(t, server)
+
+	var events []ProposalEvent
+	w := proposalWatcher{govClient: gc, opts: WatchOptions{Voters: []string{alice}}, handler: func(event ProposalEvent) {
+		events = append(events, event)
+	}}
+
+	submit, submitResult := testTx(0, sdk.StringEvents{event(eventTypeSubmitProposal, AttributeKeyProposalId, "1")})
+	deposit, depositResult := testTx(0, sdk.StringEvents{
+		event(eventTypeProposalDeposit, AttributeKeyProposalId, "2", attributeKeyVotingPeriodStart, "2"),
+	})
+	vote, voteResult := testTx(0, nil,
+		&MsgVote{ProposalId: 2, Voter: alice, Option: OptionNo},
+		&MsgVote{ProposalId: 2, Voter: bob, Option: OptionYes},
+	)
+	failed, failedResult := testTx(1, sdk.StringEvents{event(eventTypeSubmitProposal, AttributeKeyProposalId, "9")},
+		&MsgVote{ProposalId: 2, Voter: alice, Option: OptionYes},
+	)
+	// alice votes with weighted options, and bob executes a vote granted by alice with authz
+	weighted := &MsgVoteWeighted{ProposalId: 2, Voter: alice, Options: []WeightedVoteOption{
+		{Option: OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: OptionAbstain, Weight: sdk.NewDecWithPrec(3, 1)},
+	}}
+	exec, err := authz.NewMsgExec(sdk.AccAddress("bob_________________"), []sdk.Msg{
+		&MsgVote{ProposalId: 2, Voter: alice, Option: OptionNoWithVeto},
+		&MsgVote{ProposalId: 2, Voter: bob, Option: OptionNo},
+	})
+	require.NoError(t, err)
+	split, splitResult := testTx(0, nil, weighted, exec)
+
+	block := sdk.BlockDetail{
+		Block: sdk.Block{
+			Header: tmtypes.Header{Height: 10},
+			Data: sdk.Data{
+				// the second tx can not be decoded
+				DecodedTxs: []sdk.Tx{submit, nil, deposit, vote, failed, split},
+				Hashes:     []string{"T0", "T1", "T2", "T3", "T4", "T5"},
+			},
+		},
+		BlockResult: sdk.BlockResult{Height: 10, Results: sdk.ABCIResponses{
+			DeliverTx: []sdk.TxResult{submitResult, {}, depositResult, voteResult, failedResult, splitResult},
+			EndBlock: sdk.ResultEndBlock{Events: sdk.StringEvents{
+				// the attributes of the proposals ended in the block are merged
+				event(eventTypeActiveProposal,
+					AttributeKeyProposalId, "3", attributeKeyProposalResult, attributeValueProposalPassed,
+					AttributeKeyProposalId, "4", attributeKeyProposalResult, attributeValueProposalRejected,
+					AttributeKeyProposalId, "5", attributeKeyProposalResult, attributeValueProposalRejected,
+				),
+				event(eventTypeInactiveProposal, AttributeKeyProposalId, "6", attributeKeyProposalResult, attributeValueProposalDropped),
+			}},
+		}},
+	}
+
+	w.handleBlock(block)
+	require.Equal(t, []summary{
+		{Type: ProposalSubmitted, ProposalId: 1, TxHash: "T0", Status: "PROPOSAL_STATUS_DEPOSIT_PERIOD"},
+		{Type: ProposalVotingStarted, ProposalId: 2, TxHash: "T2", Status: "PROPOSAL_STATUS_VOTING_PERIOD", Pending: []string{alice}},
+		{Type: ProposalVoted, ProposalId: 2, TxHash: "T3", Voter: alice, Option: "VOTE_OPTION_NO"},
+		{Type: ProposalVoted, ProposalId: 2, TxHash: "T5", Voter: alice, Options: weighted.Options},
+		{Type: ProposalVoted, ProposalId: 2, TxHash: "T5", Voter: alice, Option: "VOTE_OPTION_NO_WITH_VETO"},
+		{Type: ProposalPassed, ProposalId: 3, Status: "PROPOSAL_STATUS_PASSED"},
+		{Type: ProposalVetoed, ProposalId: 4, Status: "PROPOSAL_STATUS_REJECTED"},
+		{Type: ProposalRejected, ProposalId: 5, Status: "PROPOSAL_STATUS_REJECTED"},
+		{Type: ProposalDropped, ProposalId: 6},
+	}, summarize(events))
+	for _, e := range events {
+		require.Equal(t, int64(10), e.Height)
+	}
+}
+
+// testBlock returns a block of the txs, hashed T0, T1...
+func testBlock(height int64, txs ...func() (sdk.Tx, sdk.TxResult)) sdk.BlockDetail {
+	block := sdk.BlockDetail{
+		Block:       sdk.Block{Header: tmtypes.Header{Height: height}},
+		BlockResult: sdk.BlockResult{Height: height},
+	}
+	for i, tx := range txs {
+		decoded, result := tx()
+		block.Block.DecodedTxs = append(block.Block.DecodedTxs, decoded)
+		block.Block.Hashes = append(block.Block.Hashes, fmt.Sprintf("T%d", i))
+		block.BlockResult.Results.DeliverTx = append(block.BlockResult.Results.DeliverTx, result)
+	}
+	return block
+}
+
+func TestWatchProposalsCatchUp(t *testing.T) {
+	server := &govServer{
+		proposals: map[uint64]Proposal{
+			1: testProposal(t, 1, StatusPassed, 10, 0, 0),
+			2: testProposal(t, 2, StatusDepositPeriod, 0, 0, 0),
+			3: testProposal(t, 3, StatusVotingPeriod, 0, 0, 0),
+			4: testProposal(t, 4, StatusVotingPeriod, 0, 0, 0),
+		},
+		votes: map[uint64][]string{4: {alice}},
+	}
+	// proposal 2 is submitted at height 3, and proposal 3 enters the voting period at height 4
+	blocks := []sdk.BlockDetail{
+		testBlock(2),
+		testBlock(3, func() (sdk.Tx, sdk.TxResult) {
+			return testTx(0, sdk.StringEvents{event(eventTypeSubmitProposal, AttributeKeyProposalId, "2")})
+		}),
+		testBlock(4, func() (sdk.Tx, sdk.TxResult) {
+			return testTx(0, sdk.StringEvents{
+				event(eventTypeProposalDeposit, AttributeKeyProposalId, "3", attributeKeyVotingPeriodStart, "3"),
+			})
+		}),
+	}
+	gc := newTestGovClient(t, server, blocks...)
+
+	watch := func(fromHeight int64) []ProposalEvent {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		var events []ProposalEvent
+		err := gc.WatchProposals(ctx, WatchOptions{Voters: []string{alice, bob}, FromHeight: fromHeight}, func(event ProposalEvent) {
+			events = append(events, event)
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		return events
+	}
+
+	// the open proposals are notified at the latest state, then the next blocks are watched
+	require.Equal(t, []summary{
+		{Type: ProposalSubmitted, ProposalId: 2, Status: "PROPOSAL_STATUS_DEPOSIT_PERIOD"},
+		{Type: ProposalVotingStarted, ProposalId: 3, Status: "PROPOSAL_STATUS_VOTING_PERIOD", Pending: []string{alice, bob}},
+		{Type: ProposalVotingStarted, ProposalId: 4, Status: "PROPOSAL_STATUS_VOTING_PERIOD", Pending: []string{bob}},
+	}, summarize(watch(0)))
+
+	// from a past height, the proposals are notified once from the blocks replayed
+	events := watch(3)
+	require.Equal(t, []summary{
+		{Type: ProposalSubmitted, ProposalId: 2, TxHash: "T0", Status: "PROPOSAL_STATUS_DEPOSIT_PERIOD"},
+		{Type: ProposalVotingStarted, ProposalId: 3, TxHash: "T0", Status: "PROPOSAL_STATUS_VOTING_PERIOD", Pending: []string{alice, bob}},
+	}, summarize(events))
+	require.Equal(t, int64(3), events[0].Height)
+	require.Equal(t, int64(4), events[1].Height)
+}
@@ -1,17 +1,51 @@
 package modules
 
 import (
+	"context"
+	"time"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
 type grpcClient struct {
-	url string
+	url  string
+	opts []grpc.DialOption
 }
 
-func NewGRPCClient(url string) grpcClient {
-	return grpcClient{url: url}
+func NewGRPCClient(url string, opts ...grpc.DialOption) grpcClient {
+	return grpcClient{url: url, opts: opts}
 }
 
 func (g grpcClient) GenConn() (*grpc.ClientConn, error) {
-	return grpc.Dial(g.url, grpc.WithInsecure())
+	return grpc.Dial(g.url, append([]grpc.DialOption{grpc.WithInsecure()}, g.opts...)...)
+}
+
+// metricsInterceptor observes the duration and status code of every unary grpc call
+func metricsInterceptor(metrics sdk.Metrics) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		metrics.GRPCQuery(method, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
+
+// retryInterceptor retries the unary grpc calls failed with a retryable error according to policy
+func retryInterceptor(policy sdk.RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if !policy.ShouldRetry(attempt, err) {
+				return err
+			}
+			if e := policy.Wait(ctx, attempt); e != nil {
+				return err
+			}
+		}
+	}
 }
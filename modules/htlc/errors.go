@@ -0,0 +1,17 @@
+package htlc
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the htlc module of irishub, use errors.Is to match them
+var (
+	ErrInvalidAddress  = sdk.Register(ModuleName, 2, "invalid address")
+	ErrInvalidHashLock = sdk.Register(ModuleName, 3, "invalid hash lock")
+	ErrInvalidTimeLock = sdk.Register(ModuleName, 4, "invalid time lock")
+	ErrInvalidSecret   = sdk.Register(ModuleName, 5, "invalid secret")
+	ErrHTLCExists      = sdk.Register(ModuleName, 6, "htlc already exists")
+	ErrUnknownHTLC     = sdk.Register(ModuleName, 7, "unknown htlc")
+	ErrHTLCNotOpen     = sdk.Register(ModuleName, 8, "htlc not open")
+	ErrHTLCNotExpired  = sdk.Register(ModuleName, 9, "htlc not expired")
+)
@@ -0,0 +1,75 @@
+package htlc
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	eventTypeCreateHTLC  = "create_htlc"
+	attributeKeyReceiver = "receiver"
+)
+
+var _ sdk.HistoryModule = htlcClient{}
+
+// CreateHTLCActivity is the activity of a MsgCreateHTLC
+type CreateHTLCActivity struct {
+	Sender   string    `json:"sender"`
+	To       string    `json:"to"`
+	Amount   sdk.Coins `json:"amount"`
+	HashLock string    `json:"hash_lock"`
+}
+
+func (a CreateHTLCActivity) Module() string { return ModuleName }
+func (a CreateHTLCActivity) Action() string { return eventTypeCreateHTLC }
+
+// ClaimHTLCActivity is the activity of a MsgClaimHTLC
+type ClaimHTLCActivity struct {
+	Sender   string `json:"sender"`
+	HashLock string `json:"hash_lock"`
+	Secret   string `json:"secret"`
+}
+
+func (a ClaimHTLCActivity) Module() string { return ModuleName }
+func (a ClaimHTLCActivity) Action() string { return "claim_htlc" }
+
+// RefundHTLCActivity is the activity of a MsgRefundHTLC
+type RefundHTLCActivity struct {
+	Sender   string `json:"sender"`
+	HashLock string `json:"hash_lock"`
+}
+
+func (a RefundHTLCActivity) Module() string { return ModuleName }
+func (a RefundHTLCActivity) Action() string { return "refund_htlc" }
+
+// HistoryQueries returns the query of the htlcs created for the address
+func (hc htlcClient) HistoryQueries(address string) []*sdk.EventQueryBuilder {
+	return []*sdk.EventQueryBuilder{
+		sdk.NewEventQueryBuilder().AddCondition(
+			sdk.NewCond(eventTypeCreateHTLC, attributeKeyReceiver).EQ(sdk.EventValue(address)),
+		),
+	}
+}
+
+func (hc htlcClient) ParseActivity(msg sdk.Msg) (sdk.Activity, bool) {
+	switch msg := msg.(type) {
+	case *MsgCreateHTLC:
+		return CreateHTLCActivity{
+			Sender:   msg.Sender,
+			To:       msg.To,
+			Amount:   msg.Amount,
+			HashLock: msg.HashLock,
+		}, true
+	case *MsgClaimHTLC:
+		return ClaimHTLCActivity{
+			Sender:   msg.Sender,
+			HashLock: msg.HashLock,
+			Secret:   msg.Secret,
+		}, true
+	case *MsgRefundHTLC:
+		return RefundHTLCActivity{
+			Sender:   msg.Sender,
+			HashLock: msg.HashLock,
+		}, true
+	}
+	return nil, false
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/irisnet/irishub-sdk-go/crypto"
 	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	"github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/store"
 )
@@ -55,6 +56,7 @@ func (k keyManager) Insert(name, password string) (string, string, error) {
 		PubKey:       cryptoamino.MarshalPubkey(pubKey),
 		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
 		Algo:         k.algo,
+		HDPath:       hd.FullPath,
 	}
 
 	if err = k.keyDAO.Write(name, password, info); err != nil {
@@ -64,11 +66,18 @@ func (k keyManager) Insert(name, password string) (string, string, error) {
 }
 
 func (k keyManager) Recover(name, password, mnemonic string) (string, error) {
+	return k.RecoverWithHDPath(name, password, mnemonic, "", hd.FullPath)
+}
+
+func (k keyManager) RecoverWithHDPath(name, password, mnemonic, bip39Passphrase, hdPath string) (string, error) {
 	if k.keyDAO.Has(name) {
 		return "", fmt.Errorf("name %s has existed", name)
 	}
+	if len(hdPath) == 0 {
+		hdPath = hd.FullPath
+	}
 
-	km, err := crypto.NewMnemonicKeyManager(mnemonic, k.algo)
+	km, err := crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, k.algo, bip39Passphrase, hdPath)
 	if err != nil {
 		return "", err
 	}
@@ -83,6 +92,7 @@ func (k keyManager) Recover(name, password, mnemonic string) (string, error) {
 		PubKey:       cryptoamino.MarshalPubkey(pubKey),
 		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
 		Algo:         k.algo,
+		HDPath:       hdPath,
 	}
 
 	if err = k.keyDAO.Write(name, password, info); err != nil {
@@ -92,6 +102,19 @@ func (k keyManager) Recover(name, password, mnemonic string) (string, error) {
 	return address, nil
 }
 
+func (k keyManager) Derive(mnemonic, bip39Passphrase string, hdPaths []string) ([]tmcrypto.PubKey, error) {
+	kms, err := crypto.NewMnemonicKeyManagers(mnemonic, k.algo, bip39Passphrase, hdPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := make([]tmcrypto.PubKey, len(kms))
+	for i, km := range kms {
+		pubKeys[i] = km.ExportPubKey()
+	}
+	return pubKeys, nil
+}
+
 func (k keyManager) Import(name, password, armor string) (string, error) {
 	if k.keyDAO.Has(name) {
 		return "", fmt.Errorf("%s has existed", name)
@@ -152,3 +175,11 @@ func (k keyManager) Find(name, password string) (tmcrypto.PubKey, types.AccAddre
 
 	return pubKey, types.AccAddress(pubKey.Address().Bytes()), nil
 }
+
+func (k keyManager) HDPath(name, password string) (string, error) {
+	info, err := k.keyDAO.Read(name, password)
+	if err != nil {
+		return "", types.WrapWithMessage(err, "name %s not exist", name)
+	}
+	return info.HDPath, nil
+}
@@ -0,0 +1,66 @@
+package keys
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// ADR-036 defines an off-chain sign doc which can never be a valid transaction:
+// chain-id, account number and sequence are empty and the only msg is MsgSignData.
+// See https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-036-arbitrary-signature.md
+const msgSignDataType = "sign/MsgSignData"
+
+type (
+	adr036Fee struct {
+		Amount []sdk.Coin `json:"amount"`
+		Gas    string     `json:"gas"`
+	}
+
+	adr036MsgValue struct {
+		Data   string `json:"data"`
+		Signer string `json:"signer"`
+	}
+
+	adr036Msg struct {
+		Type  string         `json:"type"`
+		Value adr036MsgValue `json:"value"`
+	}
+
+	adr036SignDoc struct {
+		AccountNumber string      `json:"account_number"`
+		ChainID       string      `json:"chain_id"`
+		Fee           adr036Fee   `json:"fee"`
+		Memo          string      `json:"memo"`
+		Msgs          []adr036Msg `json:"msgs"`
+		Sequence      string      `json:"sequence"`
+	}
+)
+
+// ArbitrarySignBytes returns the canonical ADR-036 sign bytes of data signed by signer
+func ArbitrarySignBytes(signer string, data []byte) []byte {
+	doc := adr036SignDoc{
+		AccountNumber: "0",
+		ChainID:       "",
+		Fee: adr036Fee{
+			Amount: []sdk.Coin{},
+			Gas:    "0",
+		},
+		Memo: "",
+		Msgs: []adr036Msg{{
+			Type: msgSignDataType,
+			Value: adr036MsgValue{
+				Data:   base64.StdEncoding.EncodeToString(data),
+				Signer: signer,
+			},
+		}},
+		Sequence: "0",
+	}
+
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
@@ -14,4 +14,13 @@
 //	require.NotEmpty(client.T(), address)
 //	require.NotEmpty(client.T(), mnemonic)
 //
+// Derive a batch of deposit addresses from one mnemonic, without saving them. The coin type is
+// the one of IRIS Hub (118) unless CoinType is set, e.g. to a pointer to 0 for the coin type 0.
+//
+//	accounts, err := client.Key.Derive(mnemonic, "", keys.DeriveParams{
+//		Count: 10,
+//	})
+//	require.NoError(client.T(), err)
+//	require.Len(client.T(), accounts, 10)
+//
 package keys
@@ -7,8 +7,52 @@ import (
 type Client interface {
 	Add(name, password string) (address string, mnemonic string, err sdk.Error)
 	Recover(name, password, mnemonic string) (address string, err sdk.Error)
+	// RecoverWithHDPath recovers the key at hdPath, the default path 44'/118'/0'/0/0 if empty
+	RecoverWithHDPath(name, password, mnemonic, bip39Passphrase, hdPath string) (address string, err sdk.Error)
 	Import(name, password, privKeyArmor string) (address string, err sdk.Error)
 	Export(name, password string) (privKeyArmor string, err sdk.Error)
 	Delete(name, password string) sdk.Error
 	Show(name, password string) (string, sdk.Error)
+	ShowInfo(name, password string) (KeyInfo, sdk.Error)
+
+	Derive(mnemonic, bip39Passphrase string, params DeriveParams) ([]DerivedAccount, sdk.Error)
+
+	SignArbitrary(name, password string, data []byte) (ArbitrarySignature, sdk.Error)
+	VerifyArbitrary(address string, data []byte, signature ArbitrarySignature) sdk.Error
+}
+
+// DeriveParams describes a batch of BIP44 paths: m/44'/coinType'/account'/0/(startIndex...startIndex+count-1),
+// a nil CoinType is the coin type of IRIS Hub (118)
+type DeriveParams struct {
+	CoinType   *uint32 `json:"coin_type,omitempty"`
+	Account    uint32  `json:"account"`
+	StartIndex uint32  `json:"start_index"`
+	Count      uint32  `json:"count"`
+}
+
+// KeyInfo is the public information of a stored key
+type KeyInfo struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	PubKey  string `json:"pub_key"`
+	// BIP44 path the key was derived from, empty for an imported key
+	HDPath string `json:"hd_path,omitempty"`
+}
+
+type DerivedAccount struct {
+	HDPath  string `json:"hd_path"`
+	Address string `json:"address"`
+	PubKey  string `json:"pub_key"`
+}
+
+// ArbitrarySignature has the same json format as the StdSignature returned by Keplr's signArbitrary
+type ArbitrarySignature struct {
+	PubKey    PubKey `json:"pub_key"`
+	Signature string `json:"signature"`
+}
+
+// PubKey is the amino json format of a public key
+type PubKey struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
@@ -1,6 +1,13 @@
 package keys
 
 import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/codec/legacy"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -22,6 +29,11 @@ func (k keysClient) Recover(name, password, mnemonic string) (string, sdk.Error)
 	return address, sdk.Wrap(err)
 }
 
+func (k keysClient) RecoverWithHDPath(name, password, mnemonic, bip39Passphrase, hdPath string) (string, sdk.Error) {
+	address, err := k.KeyManager.RecoverWithHDPath(name, password, mnemonic, bip39Passphrase, hdPath)
+	return address, sdk.Wrap(err)
+}
+
 func (k keysClient) Import(name, password, privKeyArmor string) (string, sdk.Error) {
 	address, err := k.KeyManager.Import(name, password, privKeyArmor)
 	return address, sdk.Wrap(err)
@@ -44,3 +56,125 @@ func (k keysClient) Show(name, password string) (string, sdk.Error) {
 	}
 	return address.String(), nil
 }
+
+// ShowInfo returns the public information of the key, including the BIP44 path it was derived from
+func (k keysClient) ShowInfo(name, password string) (KeyInfo, sdk.Error) {
+	pubKey, address, err := k.KeyManager.Find(name, password)
+	if err != nil {
+		return KeyInfo{}, sdk.Wrap(err)
+	}
+
+	hdPath, err := k.KeyManager.HDPath(name, password)
+	if err != nil {
+		return KeyInfo{}, sdk.Wrap(err)
+	}
+
+	pubKeyBech32, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return KeyInfo{}, sdk.Wrap(err)
+	}
+
+	return KeyInfo{
+		Name:    name,
+		Address: address.String(),
+		PubKey:  pubKeyBech32,
+		HDPath:  hdPath,
+	}, nil
+}
+
+// Derive derives a batch of accounts from one mnemonic without saving them to the KeyDAO
+func (k keysClient) Derive(mnemonic, bip39Passphrase string, params DeriveParams) ([]DerivedAccount, sdk.Error) {
+	if params.Count == 0 {
+		return nil, sdk.Wrapf("count must be greater than 0")
+	}
+	coinType := uint32(hd.CoinType)
+	if params.CoinType != nil {
+		coinType = *params.CoinType
+	}
+
+	hdPaths := make([]string, params.Count)
+	for i := range hdPaths {
+		hdPaths[i] = hd.CreateHDPath(coinType, params.Account, params.StartIndex+uint32(i)).String()
+	}
+
+	pubKeys, err := k.KeyManager.Derive(mnemonic, bip39Passphrase, hdPaths)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	accounts := make([]DerivedAccount, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pubKeyBech32, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+		if err != nil {
+			return nil, sdk.Wrap(err)
+		}
+
+		accounts[i] = DerivedAccount{
+			HDPath:  hdPaths[i],
+			Address: sdk.AccAddress(pubKey.Address()).String(),
+			PubKey:  pubKeyBech32,
+		}
+	}
+	return accounts, nil
+}
+
+// SignArbitrary signs the data with an ADR-036 sign doc, the signature can be verified by VerifyArbitrary or Keplr-style wallets
+func (k keysClient) SignArbitrary(name, password string, data []byte) (ArbitrarySignature, sdk.Error) {
+	pubKey, address, err := k.KeyManager.Find(name, password)
+	if err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	signature, _, err := k.KeyManager.Sign(name, password, ArbitrarySignBytes(address.String(), data))
+	if err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	bz, err := legacy.Cdc.MarshalJSON(pubKey)
+	if err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	var pk PubKey
+	if err := json.Unmarshal(bz, &pk); err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	return ArbitrarySignature{
+		PubKey:    pk,
+		Signature: base64.StdEncoding.EncodeToString(signature),
+	}, nil
+}
+
+// VerifyArbitrary verifies that the data is signed by the address with an ADR-036 sign doc
+func (k keysClient) VerifyArbitrary(address string, data []byte, signature ArbitrarySignature) sdk.Error {
+	addr, e := sdk.AccAddressFromBech32(address)
+	if e != nil {
+		return sdk.Wrap(e)
+	}
+
+	bz, err := json.Marshal(signature.PubKey)
+	if err != nil {
+		return sdk.Wrap(err)
+	}
+
+	var pubKey crypto.PubKey
+	if err := legacy.Cdc.UnmarshalJSON(bz, &pubKey); err != nil {
+		return sdk.Wrap(err)
+	}
+
+	if !addr.Equals(sdk.AccAddress(pubKey.Address())) {
+		return sdk.Wrapf("pubkey does not match the address %s", address)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature.Signature)
+	if err != nil {
+		return sdk.Wrap(err)
+	}
+
+	// the signer of the sign doc is the canonical, lower case address
+	if !pubKey.VerifySignature(ArbitrarySignBytes(addr.String(), data), sig) {
+		return sdk.Wrapf("signature verification failed")
+	}
+	return nil
+}
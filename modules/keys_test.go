@@ -0,0 +1,124 @@
+package modules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+	"github.com/irisnet/irishub-sdk-go/modules/keys"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const mnemonic = "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+func TestDerive(t *testing.T) {
+	client := keys.NewClient(keyManager{keyDAO: store.NewMemory(nil), algo: "secp256k1"})
+
+	accounts, err := client.Derive(mnemonic, "", keys.DeriveParams{Count: 3})
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	// the coin type of IRIS Hub by default
+	require.Equal(t, hd.FullPath, accounts[0].HDPath)
+	require.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", accounts[0].Address)
+	require.Equal(t, "44'/118'/0'/0/2", accounts[2].HDPath)
+	require.NotEqual(t, accounts[0].Address, accounts[1].Address)
+
+	// every account of the batch is the one recovered alone from its path
+	for i, account := range accounts {
+		address, err := client.RecoverWithHDPath(account.HDPath, "password", mnemonic, "", account.HDPath)
+		require.NoError(t, err, i)
+		require.Equal(t, account.Address, address, i)
+	}
+
+	coinType := uint32(hd.CoinType)
+	shifted, err := client.Derive(mnemonic, "", keys.DeriveParams{CoinType: &coinType, Account: 1, StartIndex: 2, Count: 1})
+	require.NoError(t, err)
+	require.Equal(t, "44'/118'/1'/0/2", shifted[0].HDPath)
+	require.NotEqual(t, accounts[2].Address, shifted[0].Address)
+
+	// the coin type 0 is honored
+	coinType = 0
+	bitcoin, err := client.Derive(mnemonic, "", keys.DeriveParams{CoinType: &coinType, Count: 1})
+	require.NoError(t, err)
+	require.Equal(t, "44'/0'/0'/0/0", bitcoin[0].HDPath)
+	require.NotEqual(t, accounts[0].Address, bitcoin[0].Address)
+
+	withPassphrase, err := client.Derive(mnemonic, "passphrase", keys.DeriveParams{Count: 1})
+	require.NoError(t, err)
+	require.NotEqual(t, accounts[0].Address, withPassphrase[0].Address)
+
+	_, err = client.Derive(mnemonic, "", keys.DeriveParams{})
+	require.Error(t, err)
+	_, err = client.Derive("nerve leader", "", keys.DeriveParams{Count: 1})
+	require.Error(t, err)
+}
+
+func TestRecoverWithHDPath(t *testing.T) {
+	keyDAO := store.NewMemory(nil)
+	client := keys.NewClient(keyManager{keyDAO: keyDAO, algo: "secp256k1"})
+
+	// an empty path is the default path
+	address, err := client.RecoverWithHDPath("default", "password", mnemonic, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", address)
+
+	info, err := client.ShowInfo("default", "password")
+	require.NoError(t, err)
+	require.Equal(t, "default", info.Name)
+	require.Equal(t, address, info.Address)
+	require.Equal(t, hd.FullPath, info.HDPath)
+
+	address, err = client.RecoverWithHDPath("other", "password", mnemonic, "", "44'/118'/1'/0/3")
+	require.NoError(t, err)
+	info, err = client.ShowInfo("other", "password")
+	require.NoError(t, err)
+	require.Equal(t, address, info.Address)
+	require.Equal(t, "44'/118'/1'/0/3", info.HDPath)
+
+	// an imported key has no path
+	armor, err := client.Export("other", "password")
+	require.NoError(t, err)
+	_, err = client.Import("imported", "password", armor)
+	require.NoError(t, err)
+	info, err = client.ShowInfo("imported", "password")
+	require.NoError(t, err)
+	require.Equal(t, address, info.Address)
+	require.Empty(t, info.HDPath)
+
+	_, err = client.RecoverWithHDPath("invalid", "password", mnemonic, "", "m/44'/118'/0'/0/0")
+	require.Error(t, err)
+}
+
+func TestSignArbitrary(t *testing.T) {
+	client := keys.NewClient(keyManager{keyDAO: store.NewMemory(nil), algo: "secp256k1"})
+	address, err := client.Recover("signer", "password", mnemonic)
+	require.NoError(t, err)
+
+	data := []byte("login nonce: 123456")
+	// the amino json sign doc of Keplr's signArbitrary, with sorted keys and without spaces
+	require.Equal(t, `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",`+
+		`"msgs":[{"type":"sign/MsgSignData","value":{"data":"bG9naW4gbm9uY2U6IDEyMzQ1Ng==","signer":"`+address+`"}}],`+
+		`"sequence":"0"}`, string(keys.ArbitrarySignBytes(address, data)))
+
+	// the deterministic (RFC 6979, low s) signature of the sha256 of the sign doc, as computed by Keplr
+	signature, err := client.SignArbitrary("signer", "password", data)
+	require.NoError(t, err)
+	require.Equal(t, keys.ArbitrarySignature{
+		PubKey: keys.PubKey{
+			Type:  "tendermint/PubKeySecp256k1",
+			Value: "AnQ3LUwMGmKvDgPi6rXF41uqe49G7Tk2v33XopTamH/9",
+		},
+		Signature: "X+oDb0PkT5MdWHaGOllT9IcbPlUO88KMBvsydobimWxoD8TtVWrvW6bsNBssortOuB20QbyPZ+txKxUca1t4Gg==",
+	}, signature)
+
+	require.NoError(t, client.VerifyArbitrary(address, data, signature))
+	// an upper case address is the same address
+	require.NoError(t, client.VerifyArbitrary(strings.ToUpper(address), data, signature))
+	require.Error(t, client.VerifyArbitrary(address, []byte("login nonce: 654321"), signature))
+
+	other, err := client.Derive(mnemonic, "", keys.DeriveParams{StartIndex: 1, Count: 1})
+	require.NoError(t, err)
+	require.Error(t, client.VerifyArbitrary(other[0].Address, data, signature))
+}
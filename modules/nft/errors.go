@@ -0,0 +1,19 @@
+package nft
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the nft module of irishub, use errors.Is to match them
+var (
+	ErrInvalidCollection = sdk.Register(ModuleName, 2, "invalid nft collection")
+	ErrUnknownCollection = sdk.Register(ModuleName, 3, "unknown nft collection")
+	ErrInvalidNFT        = sdk.Register(ModuleName, 4, "invalid nft")
+	ErrNFTAlreadyExists  = sdk.Register(ModuleName, 5, "nft already exists")
+	ErrUnknownNFT        = sdk.Register(ModuleName, 6, "unknown nft")
+	ErrEmptyTokenData    = sdk.Register(ModuleName, 7, "nft data can't be empty")
+	ErrUnauthorized      = sdk.Register(ModuleName, 8, "unauthorized address")
+	ErrInvalidDenom      = sdk.Register(ModuleName, 9, "invalid denom")
+	ErrInvalidTokenID    = sdk.Register(ModuleName, 10, "invalid nft id")
+	ErrInvalidTokenURI   = sdk.Register(ModuleName, 11, "invalid nft uri")
+)
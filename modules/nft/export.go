@@ -13,11 +13,18 @@ type Client interface {
 	BurnNFT(request BurnNFTRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 
 	QuerySupply(denomID, creator string) (uint64, sdk.Error)
-	QueryOwner(creator, denomID string) (QueryOwnerResp, sdk.Error)
+	QueryOwner(owner, denomID string) (QueryOwnerResp, sdk.Error)
+	QueryOwnerPage(owner, denomID string, key []byte, limit uint64) (QueryOwnerPageResp, sdk.Error)
 	QueryCollection(denomID string) (QueryCollectionResp, sdk.Error)
+	QueryCollectionPage(denomID string, key []byte, limit uint64) (QueryCollectionPageResp, sdk.Error)
 	QueryDenom(denomID string) (QueryDenomResp, sdk.Error)
 	QueryDenoms() ([]QueryDenomResp, sdk.Error)
+	QueryDenomsPage(key []byte, limit uint64) (QueryDenomsPageResp, sdk.Error)
 	QueryNFT(denomID, tokenID string) (QueryNFTResp, sdk.Error)
+
+	ValidateData(denomID, data string) sdk.Error
+	IterateOwnedNFTs(owner string, limit uint64) *OwnedNFTIterator
+	RebuildOwnership(denomID string) (*OwnershipIndex, sdk.Error)
 }
 
 type IssueDenomRequest struct {
@@ -68,6 +75,14 @@ type QueryOwnerResp struct {
 	IDCs    []IDC  `json:"idcs" yaml:"idcs"`
 }
 
+// QueryOwnerPageResp is a page of the nfts of an owner, NextKey is empty for the last page
+// and Total is only counted for the first page
+type QueryOwnerPageResp struct {
+	QueryOwnerResp
+	NextKey []byte `json:"next_key"`
+	Total   uint64 `json:"total"`
+}
+
 // BaseNFT non fungible token definition
 type QueryNFTResp struct {
 	ID      string `json:"id"`
@@ -88,3 +103,25 @@ type QueryCollectionResp struct {
 	Denom QueryDenomResp `json:"denom" yaml:"denom"`
 	NFTs  []QueryNFTResp `json:"nfts" yaml:"nfts"`
 }
+
+// QueryCollectionPageResp is a page of the nfts of a collection, NextKey is empty for the last page
+// and Total is only counted for the first page
+type QueryCollectionPageResp struct {
+	QueryCollectionResp
+	NextKey []byte `json:"next_key"`
+	Total   uint64 `json:"total"`
+}
+
+// QueryDenomsPageResp is a page of the denoms, NextKey is empty for the last page
+// and Total is only counted for the first page
+type QueryDenomsPageResp struct {
+	Denoms  []QueryDenomResp `json:"denoms"`
+	NextKey []byte           `json:"next_key"`
+	Total   uint64           `json:"total"`
+}
+
+// OwnedNFT is an nft of an owner
+type OwnedNFT struct {
+	DenomID string `json:"denom_id"`
+	TokenID string `json:"token_id"`
+}
@@ -0,0 +1,69 @@
+package nft
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	eventTypeTransferNFT  = "transfer_nft"
+	eventTypeMintNFT      = "mint_nft"
+	eventTypeBurnNFT      = "burn_nft"
+	attributeKeyRecipient = "recipient"
+	attributeKeyDenomID   = "denom_id"
+	attributeKeyTokenID   = "token_id"
+)
+
+var _ sdk.HistoryModule = nftClient{}
+
+// TransferNFTActivity is the activity of a MsgTransferNFT
+type TransferNFTActivity struct {
+	DenomID   string `json:"denom_id"`
+	TokenID   string `json:"token_id"`
+	Sender    string `json:"sender"`
+	Recipient string `json:"recipient"`
+}
+
+func (a TransferNFTActivity) Module() string { return ModuleName }
+func (a TransferNFTActivity) Action() string { return eventTypeTransferNFT }
+
+// MintNFTActivity is the activity of a MsgMintNFT
+type MintNFTActivity struct {
+	DenomID   string `json:"denom_id"`
+	TokenID   string `json:"token_id"`
+	Sender    string `json:"sender"`
+	Recipient string `json:"recipient"`
+}
+
+func (a MintNFTActivity) Module() string { return ModuleName }
+func (a MintNFTActivity) Action() string { return eventTypeMintNFT }
+
+// HistoryQueries returns the queries of the txs transferring or minting nfts to the address
+func (nc nftClient) HistoryQueries(address string) []*sdk.EventQueryBuilder {
+	var builders []*sdk.EventQueryBuilder
+	for _, typ := range []string{eventTypeTransferNFT, eventTypeMintNFT} {
+		builders = append(builders, sdk.NewEventQueryBuilder().AddCondition(
+			sdk.NewCond(typ, attributeKeyRecipient).EQ(sdk.EventValue(address)),
+		))
+	}
+	return builders
+}
+
+func (nc nftClient) ParseActivity(msg sdk.Msg) (sdk.Activity, bool) {
+	switch msg := msg.(type) {
+	case *MsgTransferNFT:
+		return TransferNFTActivity{
+			DenomID:   msg.DenomId,
+			TokenID:   msg.Id,
+			Sender:    msg.Sender,
+			Recipient: msg.Recipient,
+		}, true
+	case *MsgMintNFT:
+		return MintNFTActivity{
+			DenomID:   msg.DenomId,
+			TokenID:   msg.Id,
+			Sender:    msg.Sender,
+			Recipient: msg.Recipient,
+		}, true
+	}
+	return nil, false
+}
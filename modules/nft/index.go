@@ -0,0 +1,184 @@
+package nft
+
+import (
+	"sort"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// rebuildPageSize is the size of the pages of txs fetched by RebuildOwnership
+const rebuildPageSize = 100
+
+// OwnershipIndex is the owner of every nft which is not burnt, built from the mint_nft, transfer_nft
+// and burn_nft events of the txs applied in order
+type OwnershipIndex struct {
+	denomID string
+	// owners of the tokens by denom
+	owners map[string]map[string]string
+	height int64
+}
+
+// NewOwnershipIndex returns an empty index of the nfts of the denom, or of all the denoms if denomID is empty
+func NewOwnershipIndex(denomID string) *OwnershipIndex {
+	return &OwnershipIndex{
+		denomID: denomID,
+		owners:  make(map[string]map[string]string),
+	}
+}
+
+// ApplyTx applies the nft events of a successful tx, the txs must be applied in order
+func (idx *OwnershipIndex) ApplyTx(tx sdk.ResultQueryTx) {
+	if tx.Result.Code != 0 {
+		return
+	}
+
+	// the events of the tx are merged by type, so a tx minting then burning a token would mint it again:
+	// the events of its msgs are applied in the order of the msgs when the log of the tx has them
+	if logs, err := sdk.ParseABCILogs(tx.Result.Log); err == nil && len(logs) > 0 {
+		for _, log := range logs {
+			idx.ApplyEvents(log.Events)
+		}
+	} else {
+		// otherwise the merged events are applied in the order of the life of a token
+		for _, typ := range []string{eventTypeMintNFT, eventTypeTransferNFT, eventTypeBurnNFT} {
+			for _, event := range tx.Result.Events {
+				if event.Type == typ {
+					idx.ApplyEvents(sdk.StringEvents{event})
+				}
+			}
+		}
+	}
+	if tx.Height > idx.height {
+		idx.height = tx.Height
+	}
+}
+
+// ApplyEvents applies the mint_nft, transfer_nft and burn_nft events in order, the other events are ignored.
+// An event merging the events of several nfts is applied for each of them, in the order of its attributes
+func (idx *OwnershipIndex) ApplyEvents(events sdk.StringEvents) {
+	for _, event := range events {
+		switch event.Type {
+		case eventTypeMintNFT, eventTypeTransferNFT, eventTypeBurnNFT:
+		default:
+			continue
+		}
+
+		for _, attrs := range splitAttributes(event.Attributes) {
+			denomID, tokenID := attrs[attributeKeyDenomID], attrs[attributeKeyTokenID]
+			if len(denomID) == 0 || len(tokenID) == 0 || (len(idx.denomID) > 0 && denomID != idx.denomID) {
+				continue
+			}
+
+			if event.Type == eventTypeBurnNFT {
+				delete(idx.owners[denomID], tokenID)
+				continue
+			}
+			if idx.owners[denomID] == nil {
+				idx.owners[denomID] = make(map[string]string)
+			}
+			idx.owners[denomID][tokenID] = attrs[attributeKeyRecipient]
+		}
+	}
+}
+
+// splitAttributes splits the attributes of merged events, an event ends before the first key it repeats
+func splitAttributes(attributes []sdk.Attribute) []map[string]string {
+	var events []map[string]string
+	var current map[string]string
+	for _, attr := range attributes {
+		if _, ok := current[attr.Key]; ok || current == nil {
+			current = make(map[string]string)
+			events = append(events, current)
+		}
+		current[attr.Key] = attr.Value
+	}
+	return events
+}
+
+// Owner returns the owner of the nft, false if it is unknown or burnt
+func (idx *OwnershipIndex) Owner(denomID, tokenID string) (string, bool) {
+	owner, ok := idx.owners[denomID][tokenID]
+	return owner, ok
+}
+
+// NFTsOf returns the nfts of the owner, sorted by denom and token
+func (idx *OwnershipIndex) NFTsOf(owner string) []IDC {
+	var idcs []IDC
+	for _, denomID := range idx.denoms() {
+		idc := IDC{Denom: denomID}
+		for tokenID, o := range idx.owners[denomID] {
+			if o == owner {
+				idc.TokenIDs = append(idc.TokenIDs, tokenID)
+			}
+		}
+		if len(idc.TokenIDs) > 0 {
+			sort.Strings(idc.TokenIDs)
+			idcs = append(idcs, idc)
+		}
+	}
+	return idcs
+}
+
+// Len returns the number of nfts in the index
+func (idx *OwnershipIndex) Len() int {
+	var n int
+	for _, tokens := range idx.owners {
+		n += len(tokens)
+	}
+	return n
+}
+
+// Height returns the height of the last tx applied, the index is kept up to date by applying the txs after it
+func (idx *OwnershipIndex) Height() int64 {
+	return idx.height
+}
+
+func (idx *OwnershipIndex) denoms() []string {
+	denoms := make([]string, 0, len(idx.owners))
+	for denomID := range idx.owners {
+		denoms = append(denoms, denomID)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// RebuildOwnership rebuilds the owners of the nfts of the denom, or of all the denoms if denomID is empty,
+// from the events of the txs minting, transferring and burning them since the genesis
+func (nc nftClient) RebuildOwnership(denomID string) (*OwnershipIndex, sdk.Error) {
+	// the txs of the three events are searched apart then merged in the order of the chain
+	txs := make(map[string]sdk.ResultQueryTx)
+	for _, typ := range []string{eventTypeMintNFT, eventTypeTransferNFT, eventTypeBurnNFT} {
+		builder := sdk.NewEventQueryBuilder().AddCondition(sdk.NewCond(typ, attributeKeyTokenID).Exists())
+		if len(denomID) > 0 {
+			builder = sdk.NewEventQueryBuilder().AddCondition(
+				sdk.NewCond(typ, attributeKeyDenomID).EQ(sdk.EventValue(denomID)),
+			)
+		}
+
+		it := nc.IterateTxs(builder, rebuildPageSize, sdk.OrderAsc)
+		for it.Next() {
+			tx := it.Tx()
+			txs[tx.Hash] = tx
+		}
+		if err := it.Err(); err != nil {
+			return nil, sdk.Wrap(err)
+		}
+	}
+
+	ordered := make([]sdk.ResultQueryTx, 0, len(txs))
+	for _, tx := range txs {
+		ordered = append(ordered, tx)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].Height != ordered[j].Height {
+			return ordered[i].Height < ordered[j].Height
+		}
+		return ordered[i].Index < ordered[j].Index
+	})
+
+	idx := NewOwnershipIndex(denomID)
+	for _, tx := range ordered {
+		idx.ApplyTx(tx)
+	}
+	return idx, nil
+}
@@ -0,0 +1,101 @@
+package nft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// the events emitted by the nft module, with the attributes in its order
+func mintEvent(denomID, tokenID, recipient string) sdk.Event {
+	return sdk.NewEvent(eventTypeMintNFT,
+		sdk.NewAttribute(attributeKeyTokenID, tokenID),
+		sdk.NewAttribute(attributeKeyDenomID, denomID),
+		sdk.NewAttribute("token_uri", "https://example.com/"+tokenID),
+		sdk.NewAttribute(attributeKeyRecipient, recipient),
+	)
+}
+
+func transferEvent(denomID, tokenID, sender, recipient string) sdk.Event {
+	return sdk.NewEvent(eventTypeTransferNFT,
+		sdk.NewAttribute(attributeKeyTokenID, tokenID),
+		sdk.NewAttribute(attributeKeyDenomID, denomID),
+		sdk.NewAttribute("sender", sender),
+		sdk.NewAttribute(attributeKeyRecipient, recipient),
+	)
+}
+
+func burnEvent(denomID, tokenID, owner string) sdk.Event {
+	return sdk.NewEvent(eventTypeBurnNFT,
+		sdk.NewAttribute(attributeKeyDenomID, denomID),
+		sdk.NewAttribute(attributeKeyTokenID, tokenID),
+		sdk.NewAttribute("owner", owner),
+	)
+}
+
+// testTx returns a successful tx of the msgs, each emitting its events. Its events are merged by type
+// like the ones of a queried tx, the log has the events of every msg unless withLog is false
+func testTx(height int64, withLog bool, msgs ...sdk.Events) sdk.ResultQueryTx {
+	var all sdk.Events
+	var logs sdk.ABCIMessageLogs
+	for i, events := range msgs {
+		all = append(all, events...)
+		logs = append(logs, sdk.NewABCIMessageLog(uint32(i), "", events))
+	}
+
+	tx := sdk.ResultQueryTx{
+		Height: height,
+		Result: sdk.TxResult{Events: sdk.StringifyEvents(all.ToABCIEvents())},
+	}
+	if withLog {
+		tx.Result.Log = logs.String()
+	}
+	return tx
+}
+
+func TestOwnershipIndex(t *testing.T) {
+	for _, withLog := range []bool{true, false} {
+		idx := NewOwnershipIndex("")
+
+		// a batch of mints is merged into one mint_nft event
+		idx.ApplyTx(testTx(1, withLog,
+			sdk.Events{mintEvent("cats", "a", "alice")},
+			sdk.Events{mintEvent("cats", "b", "alice")},
+			sdk.Events{mintEvent("dogs", "c", "bob")},
+		))
+		require.Equal(t, 3, idx.Len())
+		require.Equal(t, []IDC{{Denom: "cats", TokenIDs: []string{"a", "b"}}}, idx.NFTsOf("alice"))
+		require.Equal(t, []IDC{{Denom: "dogs", TokenIDs: []string{"c"}}}, idx.NFTsOf("bob"))
+
+		// a token minted then burnt in the same tx is not indexed, the burn sorts before the mint in the events
+		idx.ApplyTx(testTx(2, withLog,
+			sdk.Events{mintEvent("cats", "d", "alice")},
+			sdk.Events{transferEvent("cats", "a", "alice", "bob")},
+			sdk.Events{burnEvent("cats", "d", "alice")},
+		))
+		_, ok := idx.Owner("cats", "d")
+		require.False(t, ok)
+		owner, ok := idx.Owner("cats", "a")
+		require.True(t, ok)
+		require.Equal(t, "bob", owner)
+		require.Equal(t, int64(2), idx.Height())
+
+		// a failed tx is ignored
+		failed := testTx(3, withLog, sdk.Events{burnEvent("cats", "b", "alice")})
+		failed.Result.Code = 1
+		idx.ApplyTx(failed)
+		require.Equal(t, 3, idx.Len())
+		require.Equal(t, int64(2), idx.Height())
+	}
+
+	// the index of a denom ignores the other denoms
+	idx := NewOwnershipIndex("dogs")
+	idx.ApplyTx(testTx(1, true,
+		sdk.Events{mintEvent("cats", "a", "alice")},
+		sdk.Events{mintEvent("dogs", "c", "bob")},
+	))
+	require.Equal(t, 1, idx.Len())
+	require.Empty(t, idx.NFTsOf("alice"))
+}
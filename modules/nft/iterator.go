@@ -0,0 +1,79 @@
+package nft
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// OwnedNFTIterator iterates the nfts of an owner page by page, the next page is only fetched
+// when the nfts of the current page are consumed:
+//
+//	it := client.NFT.IterateOwnedNFTs(owner, 100)
+//	for it.Next() {
+//		nft := it.NFT()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type OwnedNFTIterator struct {
+	query func(key []byte) (QueryOwnerPageResp, sdk.Error)
+
+	key     []byte
+	fetched bool
+	total   uint64
+	nfts    []OwnedNFT
+	index   int
+	cur     OwnedNFT
+	done    bool
+	err     error
+}
+
+func newOwnedNFTIterator(query func(key []byte) (QueryOwnerPageResp, sdk.Error)) *OwnedNFTIterator {
+	return &OwnedNFTIterator{query: query}
+}
+
+// Next moves to the next nft, it returns false when the nfts are exhausted or an error occurs
+func (it *OwnedNFTIterator) Next() bool {
+	for it.index >= len(it.nfts) {
+		if it.done || (it.fetched && len(it.key) == 0) {
+			it.done = true
+			return false
+		}
+
+		page, err := it.query(it.key)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+
+		if !it.fetched {
+			it.total = page.Total
+		}
+		it.fetched = true
+		it.key = page.NextKey
+		it.nfts, it.index = it.nfts[:0], 0
+		for _, idc := range page.IDCs {
+			for _, tokenID := range idc.TokenIDs {
+				it.nfts = append(it.nfts, OwnedNFT{DenomID: idc.Denom, TokenID: tokenID})
+			}
+		}
+	}
+
+	it.cur = it.nfts[it.index]
+	it.index++
+	return true
+}
+
+// NFT returns the current nft
+func (it *OwnedNFTIterator) NFT() OwnedNFT {
+	return it.cur
+}
+
+// Total returns the count of the nfts of the owner, known once the first page is fetched
+func (it *OwnedNFTIterator) Total() uint64 {
+	return it.total
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *OwnedNFTIterator) Err() error {
+	return it.err
+}
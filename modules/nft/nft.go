@@ -6,17 +6,24 @@ import (
 	"github.com/irisnet/irishub-sdk-go/codec/types"
 
 	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
 )
 
+// pageThroughSize is the size of the pages fetched by the queries returning all the items
+const pageThroughSize = 100
+
 type nftClient struct {
 	sdk.BaseClient
 	codec.Marshaler
+
+	schemas *schemaCache
 }
 
 func NewClient(bc sdk.BaseClient, cdc codec.Marshaler) Client {
 	return nftClient{
 		BaseClient: bc,
 		Marshaler:  cdc,
+		schemas:    newSchemaCache(),
 	}
 }
 
@@ -28,12 +35,18 @@ func (nc nftClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+// IssueDenom issues a denom, a schema which is a json object must be a valid json schema
+// as the data of the nfts of the denom are validated against it
 func (nc nftClient) IssueDenom(request IssueDenomRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := nc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
+	if _, err := compileSchema(request.Schema); err != nil {
+		return sdk.ResultTx{}, sdk.WrapWithMessage(err, "invalid json schema")
+	}
+
 	msg := &MsgIssueDenom{
 		Id:     request.ID,
 		Name:   request.Name,
@@ -43,12 +56,17 @@ func (nc nftClient) IssueDenom(request IssueDenomRequest, baseTx sdk.BaseTx) (sd
 	return nc.BuildAndSend([]sdk.Msg{msg}, baseTx)
 }
 
+// MintNFT mints an nft, its data is validated against the json schema of the denom if any
 func (nc nftClient) MintNFT(request MintNFTRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := nc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
+	if err := nc.ValidateData(request.Denom, request.Data); err != nil {
+		return sdk.ResultTx{}, err
+	}
+
 	var recipient = sender.String()
 	if len(request.Recipient) > 0 {
 		if err := sdk.ValidateAccAddress(request.Recipient); err != nil {
@@ -69,12 +87,20 @@ func (nc nftClient) MintNFT(request MintNFTRequest, baseTx sdk.BaseTx) (sdk.Resu
 	return nc.BuildAndSend([]sdk.Msg{msg}, baseTx)
 }
 
+// EditNFT edits an nft, its data is validated against the json schema of the denom if any,
+// unless it is [do-not-modify]
 func (nc nftClient) EditNFT(request EditNFTRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := nc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
+	if request.Data != doNotModify {
+		if err := nc.ValidateData(request.Denom, request.Data); err != nil {
+			return sdk.ResultTx{}, err
+		}
+	}
+
 	msg := &MsgEditNFT{
 		Id:      request.ID,
 		Name:    request.Name,
@@ -151,73 +177,138 @@ func (nc nftClient) QuerySupply(denom, creator string) (uint64, sdk.Error) {
 	return res.Amount, nil
 }
 
-func (nc nftClient) QueryOwner(creator, denom string) (QueryOwnerResp, sdk.Error) {
-	if len(denom) == 0 {
-		return QueryOwnerResp{}, sdk.Wrapf("denom is required")
+// QueryOwner returns all the nfts of the owner of the denom, or of all the denoms if denom is empty
+func (nc nftClient) QueryOwner(owner, denom string) (QueryOwnerResp, sdk.Error) {
+	res := QueryOwnerResp{Address: owner}
+	err := pageThrough(func(key []byte) ([]byte, sdk.Error) {
+		page, err := nc.QueryOwnerPage(owner, denom, key, pageThroughSize)
+		if err != nil {
+			return nil, err
+		}
+		res.IDCs = mergeIDCs(res.IDCs, page.IDCs)
+		return page.NextKey, nil
+	})
+	if err != nil {
+		return QueryOwnerResp{}, err
 	}
+	return res, nil
+}
 
-	if err := sdk.ValidateAccAddress(creator); err != nil {
-		return QueryOwnerResp{}, sdk.Wrap(err)
+// QueryOwnerPage returns a page of the nfts of the owner of the denom, or of all the denoms if denom is empty.
+// The page follows key, the NextKey of the previous page, or is the first one if key is empty
+func (nc nftClient) QueryOwnerPage(owner, denom string, key []byte, limit uint64) (QueryOwnerPageResp, sdk.Error) {
+	if err := sdk.ValidateAccAddress(owner); err != nil {
+		return QueryOwnerPageResp{}, sdk.Wrap(err)
 	}
 
 	conn, err := nc.GenConn()
 	defer func() { _ = conn.Close() }()
 	if err != nil {
-		return QueryOwnerResp{}, sdk.Wrap(err)
+		return QueryOwnerPageResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Owner(
 		context.Background(),
 		&QueryOwnerRequest{
-			Owner:   creator,
-			DenomId: denom,
+			Owner:      owner,
+			DenomId:    denom,
+			Pagination: pageRequest(key, limit),
 		},
 	)
 	if err != nil {
-		return QueryOwnerResp{}, sdk.Wrap(err)
+		return QueryOwnerPageResp{}, sdk.Wrap(err)
 	}
 
-	return res.Owner.Convert().(QueryOwnerResp), nil
+	return res.Convert().(QueryOwnerPageResp), nil
 }
 
+// QueryCollection returns the denom with all its nfts, see QueryCollectionPage for the large collections
 func (nc nftClient) QueryCollection(denom string) (QueryCollectionResp, sdk.Error) {
+	var res QueryCollectionResp
+	err := pageThrough(func(key []byte) ([]byte, sdk.Error) {
+		page, err := nc.QueryCollectionPage(denom, key, pageThroughSize)
+		if err != nil {
+			return nil, err
+		}
+		res.Denom = page.Denom
+		res.NFTs = append(res.NFTs, page.NFTs...)
+		return page.NextKey, nil
+	})
+	if err != nil {
+		return QueryCollectionResp{}, err
+	}
+	return res, nil
+}
+
+// QueryCollectionPage returns the denom with a page of its nfts.
+// The page follows key, the NextKey of the previous page, or is the first one if key is empty
+func (nc nftClient) QueryCollectionPage(denom string, key []byte, limit uint64) (QueryCollectionPageResp, sdk.Error) {
 	if len(denom) == 0 {
-		return QueryCollectionResp{}, sdk.Wrapf("denom is required")
+		return QueryCollectionPageResp{}, sdk.Wrapf("denom is required")
 	}
 
 	conn, err := nc.GenConn()
 	defer func() { _ = conn.Close() }()
 	if err != nil {
-		return QueryCollectionResp{}, sdk.Wrap(err)
+		return QueryCollectionPageResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Collection(
 		context.Background(),
-		&QueryCollectionRequest{DenomId: denom},
+		&QueryCollectionRequest{
+			DenomId:    denom,
+			Pagination: pageRequest(key, limit),
+		},
 	)
 	if err != nil {
-		return QueryCollectionResp{}, sdk.Wrap(err)
+		return QueryCollectionPageResp{}, sdk.Wrap(err)
 	}
 
-	return res.Collection.Convert().(QueryCollectionResp), nil
+	return res.Convert().(QueryCollectionPageResp), nil
 }
 
+// QueryDenoms returns all the denoms
 func (nc nftClient) QueryDenoms() ([]QueryDenomResp, sdk.Error) {
+	var res []QueryDenomResp
+	err := pageThrough(func(key []byte) ([]byte, sdk.Error) {
+		page, err := nc.QueryDenomsPage(key, pageThroughSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page.Denoms...)
+		return page.NextKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QueryDenomsPage returns a page of the denoms.
+// The page follows key, the NextKey of the previous page, or is the first one if key is empty
+func (nc nftClient) QueryDenomsPage(key []byte, limit uint64) (QueryDenomsPageResp, sdk.Error) {
 	conn, err := nc.GenConn()
 	defer func() { _ = conn.Close() }()
 	if err != nil {
-		return nil, sdk.Wrap(err)
+		return QueryDenomsPageResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Denoms(
 		context.Background(),
-		&QueryDenomsRequest{},
+		&QueryDenomsRequest{Pagination: pageRequest(key, limit)},
 	)
 	if err != nil {
-		return nil, sdk.Wrap(err)
+		return QueryDenomsPageResp{}, sdk.Wrap(err)
 	}
 
-	return denoms(res.Denoms).Convert().([]QueryDenomResp), nil
+	return res.Convert().(QueryDenomsPageResp), nil
+}
+
+// IterateOwnedNFTs iterates the nfts of the owner across all the denoms, fetching limit nfts per page
+func (nc nftClient) IterateOwnedNFTs(owner string, limit uint64) *OwnedNFTIterator {
+	return newOwnedNFTIterator(func(key []byte) (QueryOwnerPageResp, sdk.Error) {
+		return nc.QueryOwnerPage(owner, "", key, limit)
+	})
 }
 
 func (nc nftClient) QueryDenom(denom string) (QueryDenomResp, sdk.Error) {
@@ -266,3 +357,27 @@ func (nc nftClient) QueryNFT(denom, tokenID string) (QueryNFTResp, sdk.Error) {
 
 	return res.NFT.Convert().(QueryNFTResp), nil
 }
+
+// pageThrough queries the pages with their next keys until the last one
+func pageThrough(queryPage func(key []byte) ([]byte, sdk.Error)) sdk.Error {
+	var key []byte
+	for {
+		next, err := queryPage(key)
+		if err != nil {
+			return err
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		key = next
+	}
+}
+
+// pageRequest returns the request of the page following key, the total is only counted for the first page
+func pageRequest(key []byte, limit uint64) *query.PageRequest {
+	return &query.PageRequest{
+		Key:        key,
+		Limit:      limit,
+		CountTotal: len(key) == 0,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	query "github.com/irisnet/irishub-sdk-go/types/query"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -131,6 +132,8 @@ func (m *QuerySupplyResponse) GetAmount() uint64 {
 type QueryOwnerRequest struct {
 	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
 	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryOwnerRequest) Reset()         { *m = QueryOwnerRequest{} }
@@ -180,9 +183,17 @@ func (m *QueryOwnerRequest) GetOwner() string {
 	return ""
 }
 
+func (m *QueryOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryOwnerResponse is the response type for the Query/Owner RPC method
 type QueryOwnerResponse struct {
-	Owner *Owner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Owner      *Owner              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryOwnerResponse) Reset()         { *m = QueryOwnerResponse{} }
@@ -225,10 +236,19 @@ func (m *QueryOwnerResponse) GetOwner() *Owner {
 	return nil
 }
 
+func (m *QueryOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryCollectionRequest is the request type for the Query/Collection RPC
 // method
 type QueryCollectionRequest struct {
 	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryCollectionRequest) Reset()         { *m = QueryCollectionRequest{} }
@@ -271,10 +291,18 @@ func (m *QueryCollectionRequest) GetDenomId() string {
 	return ""
 }
 
+func (m *QueryCollectionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryCollectionResponse is the response type for the Query/Collection RPC
 // method
 type QueryCollectionResponse struct {
-	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Collection *Collection         `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryCollectionResponse) Reset()         { *m = QueryCollectionResponse{} }
@@ -317,6 +345,13 @@ func (m *QueryCollectionResponse) GetCollection() *Collection {
 	return nil
 }
 
+func (m *QueryCollectionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDenomRequest is the request type for the Query/Denom RPC method
 type QueryDenomRequest struct {
 	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
@@ -409,6 +444,8 @@ func (m *QueryDenomResponse) GetDenom() *Denom {
 
 // QueryDenomsRequest is the request type for the Query/Denoms RPC method
 type QueryDenomsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryDenomsRequest) Reset()         { *m = QueryDenomsRequest{} }
@@ -444,9 +481,17 @@ func (m *QueryDenomsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDenomsRequest proto.InternalMessageInfo
 
+func (m *QueryDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDenomsResponse is the response type for the Query/Denoms RPC method
 type QueryDenomsResponse struct {
-	Denoms []Denom `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms"`
+	Denoms     []Denom             `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryDenomsResponse) Reset()         { *m = QueryDenomsResponse{} }
@@ -489,6 +534,13 @@ func (m *QueryDenomsResponse) GetDenoms() []Denom {
 	return nil
 }
 
+func (m *QueryDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryNFTRequest is the request type for the Query/NFT RPC method
 type QueryNFTRequest struct {
 	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
@@ -605,49 +657,55 @@ func init() {
 func init() { proto.RegisterFile("nft/query.proto", fileDescriptor_ce02d034d3adf2e9) }
 
 var fileDescriptor_ce02d034d3adf2e9 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xee, 0x02, 0x2d, 0xf8, 0x12, 0x83, 0x0e, 0x15, 0x48, 0x81, 0x6d, 0x33, 0xf8, 0x81, 0x1f,
-	0xdd, 0x31, 0x78, 0x30, 0xf1, 0xe0, 0xa1, 0x18, 0x90, 0x0b, 0xc6, 0x95, 0x8b, 0x5c, 0x4c, 0x61,
-	0xa7, 0xa5, 0x61, 0x77, 0x66, 0xe9, 0xcc, 0xc6, 0x10, 0x42, 0x4c, 0x8c, 0x3f, 0xc0, 0xc4, 0x3f,
-	0xc5, 0x4d, 0x12, 0x2f, 0x9e, 0x88, 0x29, 0xfe, 0x02, 0x7f, 0x81, 0x99, 0xd9, 0x59, 0xbb, 0xeb,
-	0xb6, 0xc4, 0x34, 0x9e, 0x76, 0x3e, 0x9e, 0xf7, 0x79, 0x9e, 0x79, 0xfb, 0x3e, 0x29, 0xcc, 0xb0,
-	0x96, 0x24, 0x47, 0x11, 0xed, 0x1e, 0x3b, 0x61, 0x97, 0x4b, 0x8e, 0xa6, 0x3b, 0xdd, 0x8e, 0x08,
-	0xb8, 0xe7, 0xb0, 0x96, 0xac, 0x94, 0xdb, 0xbc, 0xcd, 0xf5, 0x39, 0x51, 0xab, 0x18, 0x52, 0x59,
-	0x6a, 0x73, 0xde, 0xf6, 0x29, 0x69, 0x86, 0x1d, 0xd2, 0x64, 0x8c, 0xcb, 0xa6, 0xec, 0x70, 0x26,
-	0xcc, 0xed, 0x75, 0xc5, 0xc8, 0x5a, 0x32, 0xde, 0xe2, 0x5d, 0x40, 0xaf, 0x15, 0xfd, 0x9b, 0x28,
-	0x0c, 0xfd, 0x63, 0x97, 0x1e, 0x45, 0x54, 0x48, 0xe4, 0xc0, 0x94, 0x47, 0x19, 0x0f, 0xde, 0x75,
-	0xbc, 0x05, 0xab, 0x66, 0xad, 0x5e, 0x6b, 0xcc, 0xfe, 0xba, 0xa8, 0xce, 0x1c, 0x37, 0x03, 0xff,
-	0x19, 0x4e, 0x6e, 0xb0, 0x3b, 0xa9, 0x97, 0x5b, 0x1e, 0x2a, 0x43, 0x91, 0xbf, 0x67, 0xb4, 0xbb,
-	0x30, 0xa6, 0xc0, 0x6e, 0xbc, 0xc1, 0x75, 0x98, 0xcd, 0x70, 0x8b, 0x90, 0x33, 0x41, 0xd1, 0x1c,
-	0x94, 0x9a, 0x01, 0x8f, 0x98, 0xd4, 0xd4, 0x13, 0xae, 0xd9, 0xe1, 0xb7, 0x70, 0x53, 0xc3, 0x5f,
-	0xa9, 0xe2, 0xff, 0xeb, 0xe4, 0xb9, 0x79, 0xa5, 0xa1, 0x36, 0x46, 0x56, 0x13, 0xac, 0x22, 0x9e,
-	0x5e, 0x43, 0x4e, 0xaa, 0xb7, 0x4e, 0x0c, 0x35, 0xf5, 0x2f, 0x61, 0x4e, 0xd7, 0xaf, 0x73, 0xdf,
-	0xa7, 0xfb, 0xaa, 0x9d, 0x23, 0xfa, 0xc3, 0x2e, 0xcc, 0xe7, 0x98, 0x8c, 0x9d, 0xa7, 0x00, 0xfb,
-	0x7f, 0x4e, 0x8d, 0xa7, 0xf9, 0x8c, 0xa7, 0x54, 0x51, 0x0a, 0x8a, 0xd7, 0x4d, 0xe3, 0x5e, 0x28,
-	0x8d, 0x51, 0x8d, 0x25, 0x2d, 0x32, 0x24, 0xfd, 0x16, 0x69, 0xc0, 0xc0, 0x16, 0xc5, 0xd0, 0x18,
-	0x80, 0xcb, 0xe9, 0x7a, 0x61, 0x5c, 0xe0, 0x4d, 0x33, 0x02, 0xc9, 0xa9, 0xa1, 0x7d, 0x0c, 0x25,
-	0x5d, 0x25, 0x16, 0xac, 0xda, 0xf8, 0x60, 0xde, 0xc6, 0xc4, 0xd9, 0x45, 0xb5, 0xe0, 0x1a, 0x1c,
-	0x3e, 0x82, 0x19, 0x4d, 0xb4, 0xbd, 0xb1, 0x33, 0xea, 0x68, 0x38, 0x30, 0x25, 0xf9, 0x21, 0x65,
-	0x0a, 0x3f, 0xf6, 0x37, 0x3e, 0xb9, 0xc1, 0xee, 0xa4, 0x5e, 0x6e, 0x79, 0x78, 0x1d, 0x6e, 0xf4,
-	0x25, 0x8d, 0x71, 0x02, 0xe3, 0xac, 0x25, 0x4d, 0x37, 0xca, 0x19, 0xd7, 0x8d, 0xa6, 0xa0, 0xdb,
-	0x1b, 0x3b, 0x8d, 0xc9, 0xde, 0x45, 0x75, 0x5c, 0xd5, 0x28, 0xe4, 0xda, 0xd7, 0x22, 0x14, 0x35,
-	0x0b, 0xfa, 0x00, 0xa5, 0x38, 0x08, 0xa8, 0x9a, 0xa9, 0xcb, 0xc7, 0xaf, 0x52, 0x1b, 0x0e, 0x88,
-	0x7d, 0xe0, 0xb5, 0x8f, 0xdf, 0x7e, 0x7e, 0x19, 0x7b, 0x84, 0x1e, 0x10, 0x83, 0x54, 0x91, 0x26,
-	0xfd, 0x99, 0x10, 0xe4, 0x24, 0xe9, 0xc0, 0x29, 0x11, 0xb1, 0x6c, 0x00, 0x45, 0x3d, 0xd4, 0xc8,
-	0xce, 0xd3, 0xa7, 0x33, 0x57, 0xa9, 0x0e, 0xbd, 0x37, 0xea, 0x2b, 0x5a, 0x7d, 0x19, 0x2d, 0x66,
-	0xd4, 0x75, 0x54, 0x04, 0x39, 0xd1, 0xdf, 0x53, 0xf4, 0xc9, 0x02, 0xe8, 0x0f, 0x2c, 0x5a, 0xc9,
-	0x93, 0xe6, 0xd2, 0x54, 0xb9, 0x7d, 0x35, 0xc8, 0xc8, 0x3f, 0xd4, 0xf2, 0x77, 0xd0, 0xca, 0x3f,
-	0x3c, 0x1e, 0x85, 0x50, 0xd4, 0xf3, 0x34, 0xe8, 0xd5, 0xe9, 0xc0, 0x0c, 0x7a, 0x75, 0x26, 0x0b,
-	0xf8, 0xae, 0x96, 0xad, 0x21, 0x3b, 0x23, 0x1b, 0xcf, 0x67, 0x5a, 0xf1, 0x00, 0x4a, 0xf1, 0xb8,
-	0xa3, 0x61, 0x94, 0xe2, 0x8a, 0x1f, 0x3a, 0x9b, 0x14, 0xbc, 0xa8, 0x45, 0x6f, 0xa1, 0xd9, 0x01,
-	0xa2, 0x48, 0x80, 0x1a, 0x34, 0xb4, 0x94, 0x67, 0xe9, 0xc7, 0xa4, 0xb2, 0x3c, 0xe4, 0xd6, 0x08,
-	0x10, 0x2d, 0x70, 0x1f, 0xdd, 0xcb, 0x08, 0xb0, 0x96, 0xcc, 0x8c, 0xd0, 0x49, 0x92, 0x8f, 0xd3,
-	0xc6, 0xe6, 0x59, 0xcf, 0xb6, 0xce, 0x7b, 0xb6, 0xf5, 0xa3, 0x67, 0x5b, 0x9f, 0x2f, 0xed, 0xc2,
-	0xf9, 0xa5, 0x5d, 0xf8, 0x7e, 0x69, 0x17, 0x76, 0xeb, 0xed, 0x8e, 0x3c, 0x88, 0xf6, 0x9c, 0x7d,
-	0x1e, 0x68, 0x32, 0x46, 0xa5, 0xfe, 0x1e, 0x44, 0x7b, 0x75, 0xe1, 0x1d, 0xd6, 0xdb, 0x9c, 0x04,
-	0xdc, 0x8b, 0x7c, 0x2a, 0x14, 0xff, 0x5e, 0x49, 0xff, 0x03, 0x3d, 0xf9, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x40, 0xb6, 0x0e, 0xd9, 0xe4, 0x06, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0xb4, 0xb4, 0xf0, 0x7b, 0xe4, 0x17, 0x74, 0x8a, 0xd0, 0x14, 0xd8, 0x36, 0x8b, 0x02,
+	0xa2, 0xdd, 0x11, 0x3c, 0x98, 0x78, 0xf0, 0x50, 0x48, 0x09, 0x17, 0xd4, 0xca, 0x89, 0x98, 0x98,
+	0x6d, 0x3b, 0x5d, 0x1a, 0xba, 0x33, 0xa5, 0xb3, 0xab, 0x21, 0x84, 0x98, 0x18, 0x13, 0xaf, 0x24,
+	0x1e, 0x3d, 0xf9, 0xdf, 0x70, 0x93, 0xc4, 0x8b, 0x27, 0x62, 0x8a, 0x7f, 0x81, 0x7f, 0x81, 0xd9,
+	0x99, 0xa9, 0xdd, 0xb5, 0x2d, 0x9a, 0x86, 0x53, 0x67, 0x67, 0xbe, 0xf7, 0xbe, 0x6f, 0xbe, 0xf7,
+	0xe6, 0xa5, 0x30, 0xc5, 0xea, 0x1e, 0x39, 0xf4, 0x69, 0xfb, 0xc8, 0x6a, 0xb5, 0xb9, 0xc7, 0xf1,
+	0x64, 0xa3, 0xdd, 0x10, 0x2e, 0xaf, 0x59, 0xac, 0xee, 0x65, 0x57, 0xab, 0x5c, 0xb8, 0x5c, 0x90,
+	0x8a, 0x2d, 0xa8, 0x42, 0x91, 0xd7, 0x6b, 0x15, 0xea, 0xd9, 0x6b, 0xa4, 0x65, 0x3b, 0x0d, 0x66,
+	0x7b, 0x0d, 0xce, 0x54, 0x60, 0x76, 0xda, 0xe1, 0x0e, 0x97, 0x4b, 0x12, 0xac, 0xf4, 0xee, 0xbc,
+	0xc3, 0xb9, 0xd3, 0xa4, 0xc4, 0x6e, 0x35, 0x88, 0xcd, 0x18, 0xf7, 0x64, 0x88, 0xd0, 0xa7, 0xff,
+	0x07, 0xec, 0xac, 0xee, 0xa9, 0x4f, 0x73, 0x0f, 0xf0, 0xf3, 0x80, 0xe4, 0x85, 0xdf, 0x6a, 0x35,
+	0x8f, 0xca, 0xf4, 0xd0, 0xa7, 0xc2, 0xc3, 0x16, 0x4c, 0xd4, 0x28, 0xe3, 0xee, 0xab, 0x46, 0x2d,
+	0x83, 0xf2, 0x68, 0xe5, 0xbf, 0x62, 0xfa, 0xe7, 0x45, 0x6e, 0xea, 0xc8, 0x76, 0x9b, 0x8f, 0xcd,
+	0xee, 0x89, 0x59, 0x1e, 0x97, 0xcb, 0xed, 0x1a, 0x9e, 0x86, 0x24, 0x7f, 0xc3, 0x68, 0x3b, 0x13,
+	0x0f, 0xc0, 0x65, 0xf5, 0x61, 0x16, 0x20, 0x1d, 0xc9, 0x2d, 0x5a, 0x9c, 0x09, 0x8a, 0x67, 0x20,
+	0x65, 0xbb, 0xdc, 0x67, 0x9e, 0x4c, 0x3d, 0x56, 0xd6, 0x5f, 0xe6, 0x67, 0x04, 0x37, 0x25, 0xfe,
+	0x69, 0x10, 0x7d, 0xad, 0x52, 0x70, 0x09, 0xa0, 0xe7, 0x5e, 0x26, 0x91, 0x47, 0x2b, 0x93, 0xeb,
+	0x4b, 0x96, 0xb2, 0xda, 0x0a, 0xac, 0xb6, 0x54, 0x41, 0xb4, 0xd5, 0xd6, 0x33, 0xdb, 0xa1, 0x5a,
+	0x41, 0x39, 0x14, 0x69, 0x7e, 0x40, 0x80, 0xc3, 0x1a, 0xf5, 0x95, 0x56, 0xba, 0xa4, 0x48, 0x66,
+	0xc6, 0x56, 0xa8, 0xa2, 0x96, 0x82, 0x6a, 0x21, 0x5b, 0x11, 0x21, 0x71, 0x09, 0x5f, 0xfe, 0xab,
+	0x10, 0x45, 0x13, 0x51, 0x72, 0x8a, 0x60, 0x46, 0x2a, 0xd9, 0xe0, 0xcd, 0x26, 0xad, 0x06, 0x7b,
+	0xa3, 0x5a, 0x56, 0x1a, 0xa0, 0x69, 0x14, 0x73, 0x3e, 0x21, 0x98, 0xed, 0x93, 0xa4, 0x1d, 0x7a,
+	0x04, 0x50, 0xfd, 0xbd, 0xab, 0x6d, 0x9a, 0x8d, 0xd8, 0x14, 0x0a, 0x0a, 0x41, 0xaf, 0xcf, 0xb0,
+	0x0d, 0xdd, 0x5d, 0x9b, 0xc1, 0xad, 0x47, 0xb4, 0xca, 0x7c, 0x02, 0x38, 0x9c, 0xa4, 0x57, 0x7e,
+	0x09, 0x18, 0x58, 0x7e, 0x05, 0x55, 0x00, 0xf3, 0x65, 0x38, 0x5e, 0x74, 0x55, 0x44, 0x0b, 0x80,
+	0x46, 0x2e, 0xc0, 0x29, 0x82, 0x74, 0x24, 0xbd, 0xd6, 0xf7, 0x00, 0x52, 0x92, 0x5e, 0x64, 0x50,
+	0x3e, 0x31, 0x58, 0x60, 0x71, 0xec, 0xec, 0x22, 0x17, 0x2b, 0x6b, 0xdc, 0xf5, 0xb9, 0x7e, 0x08,
+	0x53, 0x52, 0xd1, 0x4e, 0x69, 0x77, 0xd4, 0xf6, 0xb4, 0x60, 0xc2, 0xe3, 0x07, 0x94, 0x05, 0xf8,
+	0xf8, 0x9f, 0xf8, 0xee, 0x89, 0x59, 0x1e, 0x97, 0xcb, 0xed, 0x9a, 0xb9, 0x01, 0x37, 0x7a, 0x94,
+	0xda, 0x01, 0x02, 0x09, 0x56, 0xf7, 0xb4, 0xb5, 0xd3, 0x91, 0xeb, 0x17, 0x6d, 0x41, 0x77, 0x4a,
+	0xbb, 0xc5, 0xf1, 0xce, 0x45, 0x2e, 0x11, 0xc4, 0x04, 0xc8, 0xf5, 0x2f, 0x49, 0x48, 0xca, 0x2c,
+	0xf8, 0x2d, 0xa4, 0xd4, 0x00, 0xc3, 0xb9, 0x48, 0x5c, 0xff, 0xd8, 0xcc, 0xe6, 0x87, 0x03, 0x94,
+	0x0e, 0x73, 0xfd, 0xdd, 0xd7, 0x1f, 0x1f, 0xe3, 0xf7, 0xf1, 0x2a, 0xd1, 0xc8, 0x60, 0x14, 0x93,
+	0x5e, 0xbb, 0x0b, 0x72, 0xdc, 0x75, 0xe0, 0x84, 0x08, 0x45, 0xeb, 0x42, 0x52, 0x8e, 0x10, 0x6c,
+	0xf4, 0xa7, 0x0f, 0x8f, 0xca, 0x6c, 0x6e, 0xe8, 0xb9, 0x66, 0x5f, 0x94, 0xec, 0x0b, 0x78, 0x2e,
+	0xc2, 0x2e, 0x07, 0x93, 0x20, 0xc7, 0xf2, 0xf7, 0x04, 0xbf, 0x47, 0x00, 0xbd, 0xb7, 0x88, 0x17,
+	0xfb, 0x93, 0xf6, 0x4d, 0x9c, 0xec, 0xed, 0xab, 0x41, 0x9a, 0xfe, 0x9e, 0xa4, 0xbf, 0x83, 0x17,
+	0xff, 0xe1, 0xf2, 0xb8, 0x05, 0x49, 0xd9, 0x98, 0x83, 0x6e, 0x1d, 0x7e, 0xc2, 0xd9, 0xdc, 0xd0,
+	0x73, 0x4d, 0xbb, 0x24, 0x69, 0xf3, 0xd8, 0x88, 0xd0, 0xaa, 0x46, 0x0f, 0x33, 0xee, 0x43, 0x6a,
+	0x53, 0x75, 0xff, 0xb0, 0x94, 0xe2, 0x8a, 0x42, 0x47, 0x9f, 0x9c, 0x39, 0x27, 0x49, 0x6f, 0xe1,
+	0xf4, 0x00, 0x52, 0x2c, 0x20, 0x68, 0x34, 0x3c, 0xdf, 0x9f, 0xa5, 0xf7, 0x4c, 0xb2, 0x0b, 0x43,
+	0x4e, 0x35, 0x01, 0x91, 0x04, 0x77, 0xf1, 0x72, 0x84, 0x80, 0xd5, 0xbd, 0x48, 0x0b, 0x1d, 0x77,
+	0xdf, 0xc7, 0x49, 0x71, 0xeb, 0xac, 0x63, 0xa0, 0xf3, 0x8e, 0x81, 0xbe, 0x77, 0x0c, 0x74, 0x7a,
+	0x69, 0xc4, 0xce, 0x2f, 0x8d, 0xd8, 0xb7, 0x4b, 0x23, 0xb6, 0x57, 0x70, 0x1a, 0xde, 0xbe, 0x5f,
+	0xb1, 0xaa, 0xdc, 0x95, 0xc9, 0x18, 0xf5, 0xe4, 0xef, 0xbe, 0x5f, 0x29, 0x88, 0xda, 0x41, 0xc1,
+	0xe1, 0xc4, 0xe5, 0x35, 0xbf, 0x49, 0x45, 0x90, 0xbf, 0x92, 0x92, 0xff, 0x1c, 0x1e, 0xfe, 0x1a,
+	0x00, 0x97, 0x05, 0xe5, 0x27, 0xc8, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,6 +1065,18 @@ func (m *QueryOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1044,6 +1114,18 @@ func (m *QueryOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Owner != nil {
 		{
 			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
@@ -1079,6 +1161,18 @@ func (m *QueryCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.DenomId) > 0 {
 		i -= len(m.DenomId)
 		copy(dAtA[i:], m.DenomId)
@@ -1109,6 +1203,18 @@ func (m *QueryCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Collection != nil {
 		{
 			size, err := m.Collection.MarshalToSizedBuffer(dAtA[:i])
@@ -1209,6 +1315,18 @@ func (m *QueryDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1232,6 +1350,18 @@ func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Denoms) > 0 {
 		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1375,6 +1505,10 @@ func (m *QueryOwnerRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1388,6 +1522,10 @@ func (m *QueryOwnerResponse) Size() (n int) {
 		l = m.Owner.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1401,6 +1539,10 @@ func (m *QueryCollectionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1414,6 +1556,10 @@ func (m *QueryCollectionResponse) Size() (n int) {
 		l = m.Collection.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1449,6 +1595,10 @@ func (m *QueryDenomsRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1464,6 +1614,10 @@ func (m *QueryDenomsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1785,6 +1939,42 @@ func (m *QueryOwnerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1874,6 +2064,42 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1959,6 +2185,42 @@ func (m *QueryCollectionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2048,6 +2310,42 @@ func (m *QueryCollectionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2275,6 +2573,42 @@ func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2362,6 +2696,42 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,109 @@
+package nft
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// doNotModify is the data of an EditNFTRequest leaving the data of the nft unchanged
+const doNotModify = "[do-not-modify]"
+
+// ErrDataSchemaMismatch is returned when the data of an nft does not match the json schema of its denom,
+// use errors.Is to match it
+var ErrDataSchemaMismatch = errors.New("nft data does not match the schema of the denom")
+
+// schemaCache caches the json schemas of the denoms, which can not be changed once issued.
+// A denom whose schema is not a json schema is cached with a nil schema, its nfts have free-form data
+type schemaCache struct {
+	mu      sync.RWMutex
+	schemas map[string]*gojsonschema.Schema
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{schemas: make(map[string]*gojsonschema.Schema)}
+}
+
+func (c *schemaCache) get(denomID string) (*gojsonschema.Schema, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	schema, ok := c.schemas[denomID]
+	return schema, ok
+}
+
+func (c *schemaCache) set(denomID string, schema *gojsonschema.Schema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schemas[denomID] = schema
+}
+
+// ValidateData validates data against the json schema of the denom, fetched once with QueryDenom.
+// The data of the denoms whose schema is not a json schema is not validated
+func (nc nftClient) ValidateData(denomID, data string) sdk.Error {
+	schema, ok := nc.schemas.get(denomID)
+	if !ok {
+		denom, err := nc.QueryDenom(denomID)
+		if err != nil {
+			return err
+		}
+		// a schema which does not compile is free-form as well, e.g. a denom issued without this client
+		compiled, e := compileSchema(denom.Schema)
+		if e == nil {
+			schema = compiled
+		}
+		nc.schemas.set(denomID, schema)
+	}
+	if schema == nil {
+		return nil
+	}
+
+	res, err := schema.Validate(gojsonschema.NewStringLoader(data))
+	if err != nil {
+		return sdk.WrapWithMessage(ErrDataSchemaMismatch, "data of denom %s is not json: %s", denomID, err.Error())
+	}
+	if !res.Valid() {
+		var errs []string
+		for _, e := range res.Errors() {
+			errs = append(errs, e.String())
+		}
+		return sdk.WrapWithMessage(ErrDataSchemaMismatch, "data of denom %s is invalid: %s", denomID, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// compileSchema compiles a schema which is a json object, it returns nil for the other schemas
+func compileSchema(schema string) (*gojsonschema.Schema, error) {
+	if !isJSONObject(schema) {
+		return nil, nil
+	}
+	return gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema))
+}
+
+func isJSONObject(s string) bool {
+	var obj map[string]json.RawMessage
+	return json.Unmarshal([]byte(s), &obj) == nil
+}
+
+// MarshalData marshals v, e.g. a struct of the metadata of an nft, to the json data of an nft
+func MarshalData(v interface{}) (string, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// UnmarshalData unmarshals the json data of an nft into v, a pointer to e.g. a struct of the metadata
+func UnmarshalData(data string, v interface{}) error {
+	return json.Unmarshal([]byte(data), v)
+}
+
+// UnmarshalData unmarshals the json data of the nft into v, a pointer to e.g. a struct of the metadata
+func (n QueryNFTResp) UnmarshalData(v interface{}) error {
+	return UnmarshalData(n.Data, v)
+}
@@ -0,0 +1,50 @@
+package nft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+	"type": "object",
+	"properties": {"name": {"type": "string"}, "level": {"type": "integer", "minimum": 1}},
+	"required": ["name"]
+}`
+
+func TestCompileSchema(t *testing.T) {
+	// the schemas which are not json objects are free-form
+	for _, schema := range []string{"", "free-form", `"string"`, `["array"]`, "{"} {
+		compiled, err := compileSchema(schema)
+		require.NoError(t, err, schema)
+		require.Nil(t, compiled, schema)
+	}
+
+	compiled, err := compileSchema(testSchema)
+	require.NoError(t, err)
+	require.NotNil(t, compiled)
+
+	// a json object which is not a json schema does not compile
+	_, err = compileSchema(`{"type": 1}`)
+	require.Error(t, err)
+}
+
+func TestValidateData(t *testing.T) {
+	compiled, err := compileSchema(testSchema)
+	require.NoError(t, err)
+
+	// the schemas are cached, so the denoms are not queried
+	nc := nftClient{schemas: newSchemaCache()}
+	nc.schemas.set("cats", compiled)
+	nc.schemas.set("dogs", nil)
+
+	require.NoError(t, nc.ValidateData("cats", `{"name":"tom","level":2}`))
+	require.NoError(t, nc.ValidateData("dogs", "free-form"))
+
+	for _, data := range []string{`{"level":2}`, `{"name":"tom","level":0}`, "", "not json"} {
+		err := nc.ValidateData("cats", data)
+		require.Error(t, err, data)
+		require.True(t, errors.Is(err, ErrDataSchemaMismatch), data)
+	}
+}
@@ -281,3 +281,48 @@ func (c Collection) Convert() interface{} {
 		NFTs:  nfts,
 	}
 }
+
+func (q QueryOwnerResponse) Convert() interface{} {
+	var res QueryOwnerPageResp
+	if q.Owner != nil {
+		res.QueryOwnerResp = q.Owner.Convert().(QueryOwnerResp)
+	}
+	if q.Pagination != nil {
+		res.NextKey, res.Total = q.Pagination.NextKey, q.Pagination.Total
+	}
+	return res
+}
+
+func (q QueryCollectionResponse) Convert() interface{} {
+	var res QueryCollectionPageResp
+	if q.Collection != nil {
+		res.QueryCollectionResp = q.Collection.Convert().(QueryCollectionResp)
+	}
+	if q.Pagination != nil {
+		res.NextKey, res.Total = q.Pagination.NextKey, q.Pagination.Total
+	}
+	return res
+}
+
+func (q QueryDenomsResponse) Convert() interface{} {
+	res := QueryDenomsPageResp{
+		Denoms: denoms(q.Denoms).Convert().([]QueryDenomResp),
+	}
+	if q.Pagination != nil {
+		res.NextKey, res.Total = q.Pagination.NextKey, q.Pagination.Total
+	}
+	return res
+}
+
+// mergeIDCs appends the idcs of a page to the previous ones, the tokens of a denom split
+// across two pages are merged
+func mergeIDCs(idcs, page []IDC) []IDC {
+	for _, idc := range page {
+		if last := len(idcs) - 1; last >= 0 && idcs[last].Denom == idc.Denom {
+			idcs[last].TokenIDs = append(idcs[last].TokenIDs, idc.TokenIDs...)
+			continue
+		}
+		idcs = append(idcs, idc)
+	}
+	return idcs
+}
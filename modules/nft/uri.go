@@ -0,0 +1,119 @@
+package nft
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	defaultIPFSGateway = "https://ipfs.io/ipfs/"
+	// maxFetchSize is the maximum size of the content fetched by the default fetcher
+	maxFetchSize = 10 << 20
+
+	schemeIPFS = "ipfs://"
+	schemeData = "data:"
+)
+
+// Fetcher fetches the content of an http or https url
+type Fetcher func(ctx context.Context, url string) ([]byte, error)
+
+// URIResolver resolves the uris of the nfts: ipfs:// uris with an ipfs gateway, data: uris inline,
+// and http or https urls as they are
+type URIResolver struct {
+	// gateway the ipfs:// uris are resolved with, https://ipfs.io/ipfs/ by default
+	IPFSGateway string
+	// fetcher of the resolved urls, a GET of http.DefaultClient by default
+	Fetcher Fetcher
+}
+
+// Resolve returns the url the uri is fetched from, the http, https and data uris are returned as they are
+func (r URIResolver) Resolve(uri string) (string, error) {
+	uri = strings.TrimSpace(uri)
+	switch {
+	case strings.HasPrefix(uri, schemeIPFS):
+		// ipfs://<cid>/<path>, or the legacy ipfs://ipfs/<cid>/<path>
+		path := strings.TrimPrefix(strings.TrimPrefix(uri, schemeIPFS), "ipfs/")
+		if len(path) == 0 {
+			return "", fmt.Errorf("missing cid in uri %s", uri)
+		}
+		gateway := r.IPFSGateway
+		if len(gateway) == 0 {
+			gateway = defaultIPFSGateway
+		}
+		return strings.TrimSuffix(gateway, "/") + "/" + path, nil
+	case strings.HasPrefix(uri, schemeData), strings.HasPrefix(uri, "http://"), strings.HasPrefix(uri, "https://"):
+		return uri, nil
+	default:
+		return "", fmt.Errorf("unsupported uri %s", uri)
+	}
+}
+
+// Fetch returns the content of the uri, the data: uris are decoded without fetching
+func (r URIResolver) Fetch(ctx context.Context, uri string) ([]byte, error) {
+	resolved, err := r.Resolve(uri)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(resolved, schemeData) {
+		return decodeDataURI(resolved)
+	}
+
+	fetch := r.Fetcher
+	if fetch == nil {
+		fetch = httpFetch
+	}
+	return fetch(ctx, resolved)
+}
+
+// FetchJSON fetches the uri and unmarshals its json content into v
+func (r URIResolver) FetchJSON(ctx context.Context, uri string, v interface{}) error {
+	bz, err := r.Fetch(ctx, uri)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// decodeDataURI decodes a data:[<media type>][;base64],<data> uri
+func decodeDataURI(uri string) ([]byte, error) {
+	i := strings.Index(uri, ",")
+	if i < 0 {
+		return nil, fmt.Errorf("missing comma in data uri")
+	}
+
+	meta, data := uri[len(schemeData):i], uri[i+1:]
+	if strings.HasSuffix(meta, ";base64") {
+		return base64.StdEncoding.DecodeString(data)
+	}
+
+	unescaped, err := url.PathUnescape(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(unescaped), nil
+}
+
+func httpFetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("fetch %s failed: %s", url, res.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(res.Body, maxFetchSize))
+}
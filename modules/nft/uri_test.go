@@ -0,0 +1,94 @@
+package nft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resolver URIResolver
+		uri      string
+		expected string
+		err      bool
+	}{
+		{name: "ipfs", uri: "ipfs://QmCid/meta.json", expected: "https://ipfs.io/ipfs/QmCid/meta.json"},
+		{name: "legacy ipfs", uri: "ipfs://ipfs/QmCid", expected: "https://ipfs.io/ipfs/QmCid"},
+		{
+			name:     "ipfs gateway",
+			resolver: URIResolver{IPFSGateway: "https://gateway.example.com/ipfs/"},
+			uri:      " ipfs://QmCid ",
+			expected: "https://gateway.example.com/ipfs/QmCid",
+		},
+		{name: "ipfs without cid", uri: "ipfs://", err: true},
+		{name: "https", uri: "https://example.com/1.json", expected: "https://example.com/1.json"},
+		{name: "http", uri: "http://example.com/1.json", expected: "http://example.com/1.json"},
+		{name: "data", uri: "data:,hello", expected: "data:,hello"},
+		{name: "unsupported", uri: "ftp://example.com/1.json", err: true},
+		{name: "empty", uri: "", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := tc.resolver.Resolve(tc.uri)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resolved)
+		})
+	}
+}
+
+func TestDecodeDataURI(t *testing.T) {
+	testCases := []struct {
+		name     string
+		uri      string
+		expected string
+		err      bool
+	}{
+		{name: "plain", uri: "data:,hello", expected: "hello"},
+		{name: "escaped", uri: `data:application/json,%7B%22name%22%3A%22cat%22%7D`, expected: `{"name":"cat"}`},
+		{name: "base64", uri: "data:application/json;base64,eyJuYW1lIjoiY2F0In0=", expected: `{"name":"cat"}`},
+		{name: "comma in the data", uri: "data:,a,b", expected: "a,b"},
+		{name: "invalid base64", uri: "data:;base64,!!", err: true},
+		{name: "invalid escape", uri: "data:,%zz", err: true},
+		{name: "missing comma", uri: "data:hello", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := decodeDataURI(tc.uri)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	var fetched []string
+	resolver := URIResolver{Fetcher: func(ctx context.Context, url string) ([]byte, error) {
+		fetched = append(fetched, url)
+		return []byte(`{"name":"dog"}`), nil
+	}}
+
+	// the data uris are decoded without fetching
+	var meta struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, resolver.FetchJSON(context.Background(), "data:,%7B%22name%22%3A%22cat%22%7D", &meta))
+	require.Equal(t, "cat", meta.Name)
+	require.Empty(t, fetched)
+
+	require.NoError(t, resolver.FetchJSON(context.Background(), "ipfs://QmCid", &meta))
+	require.Equal(t, "dog", meta.Name)
+	require.Equal(t, []string{"https://ipfs.io/ipfs/QmCid"}, fetched)
+}
@@ -0,0 +1,22 @@
+package oracle
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the oracle module of irishub, use errors.Is to match them
+var (
+	ErrUnknownFeedName          = sdk.Register(ModuleName, 2, "unknown feed")
+	ErrInvalidFeedName          = sdk.Register(ModuleName, 3, "invalid feed name")
+	ErrExistedFeedName          = sdk.Register(ModuleName, 4, "feed already exists")
+	ErrUnauthorized             = sdk.Register(ModuleName, 5, "unauthorized owner")
+	ErrInvalidServiceName       = sdk.Register(ModuleName, 6, "invalid service name")
+	ErrInvalidDescription       = sdk.Register(ModuleName, 7, "invalid description")
+	ErrNotRegisterFunc          = sdk.Register(ModuleName, 8, "method don't register")
+	ErrInvalidFeedState         = sdk.Register(ModuleName, 9, "invalid state feed")
+	ErrInvalidServiceFeeCap     = sdk.Register(ModuleName, 10, "service fee cap is invalid")
+	ErrInvalidResponseThreshold = sdk.Register(ModuleName, 11, "invalid response threshold")
+	ErrInvalidLatestHistory     = sdk.Register(ModuleName, 12, "invalid latest history")
+	ErrEmptyProviders           = sdk.Register(ModuleName, 13, "provider list is empty")
+	ErrInvalidTimeout           = sdk.Register(ModuleName, 14, "invalid timeout")
+)
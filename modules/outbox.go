@@ -0,0 +1,153 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	clienttx "github.com/irisnet/irishub-sdk-go/client/tx"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+var errOutboxDisabled = errors.New("outbox is not enabled, see types.OutboxOption")
+
+// recordTx records the signed transaction in the outbox before its first broadcast if the outbox
+// is enabled, so that a transaction is never broadcast without a durable record of it.
+func (base *baseClient) recordTx(builder *clienttx.Factory, txBytes []byte, simulate bool) sdk.Error {
+	if simulate || base.cfg.Outbox == nil {
+		return nil
+	}
+
+	now := time.Now()
+	entry := store.OutboxEntry{
+		Hash:      sdk.HexBytes(tmhash.Sum(txBytes)).String(),
+		Address:   builder.Address(),
+		Sequence:  builder.Sequence(),
+		TxBytes:   txBytes,
+		Status:    store.OutboxPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := base.cfg.Outbox.Save(entry); err != nil {
+		return sdk.WrapWithMessage(err, "save transaction %s to outbox failed", entry.Hash)
+	}
+	return nil
+}
+
+// sendTx broadcasts the signed transaction once and updates its outbox entry with the result
+func (base *baseClient) sendTx(ctx context.Context, builder *clienttx.Factory, txBytes []byte, simulate bool) (sdk.ResultTx, sdk.Error) {
+	res, err := base.broadcastTx(ctx, txBytes, builder.Mode(), simulate)
+	if !simulate {
+		base.updateOutbox(sdk.HexBytes(tmhash.Sum(txBytes)).String(), res, err)
+	}
+	return res, err
+}
+
+// updateOutbox updates the status of the entry of the transaction according to the broadcast result
+func (base *baseClient) updateOutbox(hash string, res sdk.ResultTx, err sdk.Error) {
+	if base.cfg.Outbox == nil {
+		return
+	}
+
+	entry, e := base.cfg.Outbox.Get(hash)
+	if e != nil {
+		base.Logger().Error("query outbox failed", "hash", hash, "errMsg", e.Error())
+		return
+	}
+	entry.UpdatedAt = time.Now()
+	entry.Height = res.Height
+
+	switch {
+	case err == nil && res.Height > 0:
+		entry.Status = store.OutboxCommitted
+		entry.Log = ""
+	case err == nil, errors.Is(err, sdk.ErrTxInMempoolCache):
+		entry.Status = store.OutboxBroadcast
+	case res.Height > 0 || len(err.TxHash()) > 0:
+		// failed in the block or rejected by the node
+		entry.Status = store.OutboxFailed
+		entry.Log = err.Error()
+	default:
+		// whether the transaction reached the node is unknown
+		entry.Log = err.Error()
+	}
+
+	if e := base.cfg.Outbox.Save(entry); e != nil {
+		base.Logger().Error("update outbox failed", "hash", entry.Hash, "status", entry.Status, "errMsg", e.Error())
+	}
+}
+
+// dropOutbox removes the entry of a transaction rejected by the node, which is replaced by another signing of
+// the same msgs, so that the outbox keeps one entry per intent
+func (base *baseClient) dropOutbox(txBytes []byte) {
+	if base.cfg.Outbox == nil {
+		return
+	}
+
+	hash := sdk.HexBytes(tmhash.Sum(txBytes)).String()
+	if err := base.cfg.Outbox.Delete(hash); err != nil {
+		base.Logger().Error("delete outbox entry failed", "hash", hash, "errMsg", err.Error())
+	}
+}
+
+// QueryOutbox returns the outbox entries in the given status, all entries if none is given
+func (base *baseClient) QueryOutbox(status ...store.OutboxStatus) ([]store.OutboxEntry, sdk.Error) {
+	if base.cfg.Outbox == nil {
+		return nil, sdk.Wrap(errOutboxDisabled)
+	}
+
+	entries, err := base.cfg.Outbox.List(status...)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return entries, nil
+}
+
+// QueryOutboxEntry returns the outbox entry of the given transaction hash
+func (base *baseClient) QueryOutboxEntry(hash string) (store.OutboxEntry, sdk.Error) {
+	if base.cfg.Outbox == nil {
+		return store.OutboxEntry{}, sdk.Wrap(errOutboxDisabled)
+	}
+
+	entry, err := base.cfg.Outbox.Get(hash)
+	if err != nil {
+		return store.OutboxEntry{}, sdk.Wrap(err)
+	}
+	return entry, nil
+}
+
+// ReconcileOutbox resolves the pending and broadcast entries of the outbox and returns them.
+// The transactions found on chain are marked as committed or failed, the other ones are
+// re-broadcast with the same signed bytes, which can never be executed twice.
+//
+// It requires the tx indexer of the node to be enabled. It is not called by the client: call it once
+// before sending the first transaction, to resolve the transactions left by a previous run, and then
+// periodically, to resolve the transactions broadcast in the Sync and Async modes, which stay in the
+// broadcast status until they are reconciled.
+func (base *baseClient) ReconcileOutbox() ([]store.OutboxEntry, sdk.Error) {
+	entries, err := base.QueryOutbox(store.OutboxPending, store.OutboxBroadcast)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := base.tracer.Start(context.Background(), "ReconcileOutbox")
+	defer span.End()
+
+	for i, entry := range entries {
+		res, e, found := base.findTx(ctx, tmhash.Sum(entry.TxBytes))
+		if !found {
+			base.Logger().Info("re-broadcast transaction in outbox", "hash", entry.Hash, "status", entry.Status)
+			res, e = base.broadcastTx(ctx, entry.TxBytes, sdk.Sync, false)
+		}
+		base.updateOutbox(entry.Hash, res, e)
+
+		var err error
+		if entries[i], err = base.cfg.Outbox.Get(entry.Hash); err != nil {
+			return entries, sdk.Wrap(err)
+		}
+	}
+	return entries, nil
+}
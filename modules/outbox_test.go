@@ -0,0 +1,144 @@
+package modules
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+func TestUpdateOutbox(t *testing.T) {
+	testCases := []struct {
+		name   string
+		res    sdk.ResultTx
+		err    sdk.Error
+		status store.OutboxStatus
+		log    bool
+	}{
+		{
+			name:   "committed",
+			res:    sdk.ResultTx{Height: 10},
+			status: store.OutboxCommitted,
+		},
+		{
+			name:   "accepted by the node",
+			status: store.OutboxBroadcast,
+		},
+		{
+			name:   "in the mempool cache",
+			err:    sdk.GetTxError("hash", sdk.RootCodespace, 19, "tx already exists in cache"),
+			status: store.OutboxBroadcast,
+		},
+		{
+			name:   "rejected by the node",
+			err:    sdk.GetTxError("hash", sdk.RootCodespace, 5, "insufficient funds"),
+			status: store.OutboxFailed,
+			log:    true,
+		},
+		{
+			name:   "failed in the block",
+			res:    sdk.ResultTx{Height: 10},
+			err:    sdk.GetTxError("hash", sdk.RootCodespace, 11, "out of gas"),
+			status: store.OutboxFailed,
+			log:    true,
+		},
+		{
+			name:   "unknown outcome",
+			err:    sdk.Wrapf("timed out waiting for tx to be included in a block"),
+			status: store.OutboxPending,
+			log:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			outbox := store.NewMemoryOutbox()
+			base := newTestClient(t, newTestNode(t), sdk.OutboxOption(outbox))
+			require.NoError(t, outbox.Save(store.OutboxEntry{Hash: "hash", Status: store.OutboxPending, CreatedAt: time.Now()}))
+
+			base.updateOutbox("hash", tc.res, tc.err)
+			entry, err := outbox.Get("hash")
+			require.NoError(t, err)
+			require.Equal(t, tc.status, entry.Status)
+			require.Equal(t, tc.res.Height, entry.Height)
+			require.Equal(t, tc.log, entry.Log != "")
+		})
+	}
+}
+
+func TestOutboxKeepsOneEntryPerIntent(t *testing.T) {
+	node := newTestNode(t)
+	outbox := store.NewMemoryOutbox()
+	base := newTestClient(t, node, sdk.RetryPolicyOption(retryAll), sdk.OutboxOption(outbox))
+
+	var recorder broadcastRecorder
+	node.handle("broadcast_tx_sync", recorder.handler(t, reject(32, "account sequence mismatch, expected 3, got 0"), accept))
+
+	msgs, baseTx := testSend(t, base)
+	res, err := base.BuildAndSend(msgs, baseTx)
+	require.NoError(t, err)
+	require.Len(t, recorder.txs, 2)
+
+	entries, err := base.QueryOutbox()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, res.Hash, entries[0].Hash)
+	require.Equal(t, uint64(3), entries[0].Sequence)
+	require.Equal(t, store.OutboxBroadcast, entries[0].Status)
+}
+
+func TestReconcileOutbox(t *testing.T) {
+	node := newTestNode(t)
+	outbox := store.NewMemoryOutbox()
+	base := newTestClient(t, node, sdk.OutboxOption(outbox))
+
+	committed, pending := tmtypes.Tx("committed"), tmtypes.Tx("pending")
+	for _, tx := range []tmtypes.Tx{committed, pending} {
+		require.NoError(t, outbox.Save(store.OutboxEntry{
+			Hash:      sdk.HexBytes(tx.Hash()).String(),
+			TxBytes:   tx,
+			Status:    store.OutboxBroadcast,
+			CreatedAt: time.Now(),
+		}))
+	}
+
+	node.handle("tx", func(params json.RawMessage) (interface{}, error) {
+		var p struct {
+			Hash []byte `json:"hash"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		if sdk.HexBytes(p.Hash).String() != sdk.HexBytes(committed.Hash()).String() {
+			return nil, fmt.Errorf("tx not found")
+		}
+		return &ctypes.ResultTx{Hash: p.Hash, Height: 10, Tx: committed, TxResult: abci.ResponseDeliverTx{}}, nil
+	})
+	node.handle("block", func(params json.RawMessage) (interface{}, error) {
+		return &ctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: 10, Time: time.Now()}}}, nil
+	})
+	var recorder broadcastRecorder
+	node.handle("broadcast_tx_sync", recorder.handler(t, reject(32, "account sequence mismatch, expected 3, got 0")))
+
+	entries, err := base.ReconcileOutbox()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	status := make(map[string]store.OutboxStatus)
+	for _, entry := range entries {
+		status[entry.Hash] = entry.Status
+	}
+	require.Equal(t, store.OutboxCommitted, status[sdk.HexBytes(committed.Hash()).String()])
+	// the transaction not found is broadcast again with the same bytes
+	require.Equal(t, []tmtypes.Tx{pending}, recorder.txs)
+	require.Equal(t, store.OutboxFailed, status[sdk.HexBytes(pending.Hash()).String()])
+}
@@ -0,0 +1,14 @@
+package random
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the random module of irishub, use errors.Is to match them
+var (
+	ErrInvalidReqID            = sdk.Register(ModuleName, 2, "invalid request id")
+	ErrInvalidHeight           = sdk.Register(ModuleName, 3, "invalid height, must be greater than 0")
+	ErrInvalidServiceBindings  = sdk.Register(ModuleName, 4, "no service bindings available")
+	ErrInvalidRequestContextID = sdk.Register(ModuleName, 5, "invalid request context id")
+	ErrInvalidServiceFeeCap    = sdk.Register(ModuleName, 6, "invalid service fee cap")
+)
@@ -0,0 +1,10 @@
+package record
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the record module of irishub, use errors.Is to match them
+var (
+	ErrUnknownRecord = sdk.Register(ModuleName, 2, "unknown record")
+)
@@ -0,0 +1,416 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+)
+
+// newTxDecoder returns the tx decoder of the client. If dynamic decoding is enabled, the transactions
+// which can not be decoded with the registered types are decoded again into a sdk.DynamicTx.
+func newTxDecoder(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, gc sdk.GRPCClient, logger log.Logger) sdk.TxDecoder {
+	decode := encodingConfig.TxConfig.TxDecoder()
+	if !cfg.DynamicDecode {
+		return decode
+	}
+
+	d := newDynamicDecoder(reflectionSource(gc), encodingConfig.Marshaler, encodingConfig.InterfaceRegistry)
+	return func(txBytes []byte) (sdk.Tx, error) {
+		tx, err := decode(txBytes)
+		if err == nil {
+			return tx, nil
+		}
+
+		dtx, e := d.decode(txBytes)
+		if e != nil {
+			logger.Debug("decode tx dynamically failed", "errMsg", e.Error())
+			return nil, err
+		}
+		return dtx, nil
+	}
+}
+
+// dynamicMissExpiration is how long a type which could not be resolved is not looked up again
+const dynamicMissExpiration = 1 * time.Minute
+
+// descriptorSource returns the serialized file descriptors of the file containing the symbol and of its dependencies
+type descriptorSource func(symbol protoreflect.FullName) ([][]byte, error)
+
+// dynamicDecoder decodes the msgs of unregistered types with the file descriptors fetched
+// from the grpc server reflection service of the node. The descriptors are cached once fetched,
+// and the types which could not be resolved are not looked up again until the miss expires.
+type dynamicDecoder struct {
+	mu     sync.Mutex
+	protos map[string]*descriptorpb.FileDescriptorProto
+	files  *protoregistry.Files
+	misses map[protoreflect.FullName]dynamicMiss
+
+	source         descriptorSource
+	missExpiration time.Duration
+	cdc            codec.Marshaler
+	registry       cdctypes.InterfaceRegistry
+}
+
+// dynamicMiss is a type which could not be resolved
+type dynamicMiss struct {
+	err error
+	at  time.Time
+}
+
+type dynamicTxJSON struct {
+	Body       dynamicBodyJSON `json:"body"`
+	AuthInfo   json.RawMessage `json:"auth_info"`
+	Signatures [][]byte        `json:"signatures"`
+}
+
+type dynamicBodyJSON struct {
+	Messages                    []json.RawMessage `json:"messages"`
+	Memo                        string            `json:"memo"`
+	TimeoutHeight               string            `json:"timeout_height"`
+	ExtensionOptions            []json.RawMessage `json:"extension_options"`
+	NonCriticalExtensionOptions []json.RawMessage `json:"non_critical_extension_options"`
+}
+
+func newDynamicDecoder(source descriptorSource, cdc codec.Marshaler, registry cdctypes.InterfaceRegistry) *dynamicDecoder {
+	return &dynamicDecoder{
+		protos:         make(map[string]*descriptorpb.FileDescriptorProto),
+		files:          new(protoregistry.Files),
+		misses:         make(map[protoreflect.FullName]dynamicMiss),
+		source:         source,
+		missExpiration: dynamicMissExpiration,
+		cdc:            cdc,
+		registry:       registry,
+	}
+}
+
+func (d *dynamicDecoder) decode(txBytes []byte) (sdk.Tx, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, err
+	}
+
+	// the Anys of the body are unpacked one by one below
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil, err
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := d.cdc.UnmarshalBinaryBare(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, err
+	}
+	authInfoJSON, err := d.cdc.MarshalJSON(&authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	txJSON := dynamicTxJSON{
+		Body: dynamicBodyJSON{
+			Memo:          body.Memo,
+			TimeoutHeight: strconv.FormatUint(body.TimeoutHeight, 10),
+		},
+		AuthInfo:   authInfoJSON,
+		Signatures: raw.Signatures,
+	}
+
+	var msgs []sdk.Msg
+	for _, any := range body.Messages {
+		msg, bz, err := d.decodeMsg(any)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+		txJSON.Body.Messages = append(txJSON.Body.Messages, bz)
+	}
+
+	for _, opts := range []struct {
+		anys []*cdctypes.Any
+		dst  *[]json.RawMessage
+	}{
+		{body.ExtensionOptions, &txJSON.Body.ExtensionOptions},
+		{body.NonCriticalExtensionOptions, &txJSON.Body.NonCriticalExtensionOptions},
+	} {
+		*opts.dst = []json.RawMessage{}
+		for _, any := range opts.anys {
+			bz, err := d.marshalAny(any.TypeUrl, any.Value)
+			if err != nil {
+				return nil, err
+			}
+			*opts.dst = append(*opts.dst, bz)
+		}
+	}
+
+	bz, err := json.Marshal(txJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.DynamicTx{
+		Msgs:          msgs,
+		Memo:          body.Memo,
+		TimeoutHeight: body.TimeoutHeight,
+		JSON:          bz,
+	}, nil
+}
+
+// decodeMsg decodes the msg with the registered types, or dynamically if its type is unknown
+func (d *dynamicDecoder) decodeMsg(any *cdctypes.Any) (sdk.Msg, json.RawMessage, error) {
+	var msg sdk.Msg
+	if err := d.registry.UnpackAny(any, &msg); err == nil {
+		bz, err := d.cdc.MarshalJSON(msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		bz, err = withTypeURL(any.TypeUrl, bz)
+		return msg, bz, err
+	}
+
+	bz, err := d.marshalAny(any.TypeUrl, any.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &sdk.DynamicMsg{TypeURL: any.TypeUrl, JSON: bz}, bz, nil
+}
+
+// marshalAny renders the value of type typeURL to json with the fetched descriptors
+func (d *dynamicDecoder) marshalAny(typeURL string, value []byte) (json.RawMessage, error) {
+	mt, err := d.FindMessageByURL(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := mt.New().Interface()
+	if err := protov2.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+
+	bz, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+		Resolver:        d,
+	}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return withTypeURL(typeURL, bz)
+}
+
+// FindMessageByName implements protoregistry.MessageTypeResolver
+func (d *dynamicDecoder) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	md, err := d.findMessage(name)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicpb.NewMessage(md).Type(), nil
+}
+
+// FindMessageByURL implements protoregistry.MessageTypeResolver
+func (d *dynamicDecoder) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+	return d.FindMessageByName(protoreflect.FullName(name))
+}
+
+// FindExtensionByName implements protoregistry.ExtensionTypeResolver, extensions are not supported
+func (d *dynamicDecoder) FindExtensionByName(protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return nil, protoregistry.NotFound
+}
+
+// FindExtensionByNumber implements protoregistry.ExtensionTypeResolver, extensions are not supported
+func (d *dynamicDecoder) FindExtensionByNumber(protoreflect.FullName, protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return nil, protoregistry.NotFound
+}
+
+// findMessage returns the descriptor of the message, the file declaring it is fetched from the source
+// if it is unknown. The lock is not held while fetching, so that the decoding of the known types is
+// not blocked by the source.
+func (d *dynamicDecoder) findMessage(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	d.mu.Lock()
+	desc, err := d.files.FindDescriptorByName(name)
+	miss, missed := d.misses[name]
+	d.mu.Unlock()
+
+	if err != nil {
+		if missed && time.Since(miss.at) < d.missExpiration {
+			return nil, miss.err
+		}
+
+		files, err := d.source(name)
+		d.mu.Lock()
+		if err == nil {
+			err = d.add(files)
+		}
+		if err == nil {
+			desc, err = d.files.FindDescriptorByName(name)
+		}
+		if err != nil {
+			d.misses[name] = dynamicMiss{err: err, at: time.Now()}
+		} else {
+			delete(d.misses, name)
+		}
+		d.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+	return md, nil
+}
+
+// add registers the serialized file descriptors which are not known yet, it must be called with the lock held
+func (d *dynamicDecoder) add(files [][]byte) error {
+	var paths []string
+	for _, bz := range files {
+		fdp := new(descriptorpb.FileDescriptorProto)
+		if err := protov2.Unmarshal(bz, fdp); err != nil {
+			return err
+		}
+		if _, ok := d.protos[fdp.GetName()]; !ok {
+			d.protos[fdp.GetName()] = fdp
+			paths = append(paths, fdp.GetName())
+		}
+	}
+
+	for _, path := range paths {
+		if err := d.register(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reflectionSource fetches the file descriptors from the grpc server reflection service of the node
+func reflectionSource(gc sdk.GRPCClient) descriptorSource {
+	return func(symbol protoreflect.FullName) ([][]byte, error) {
+		conn, err := gc.GenConn()
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := stream.Send(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+				FileContainingSymbol: string(symbol),
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		res, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if e := res.GetErrorResponse(); e != nil {
+			return nil, fmt.Errorf("reflection service can not resolve %s: %s", symbol, e.ErrorMessage)
+		}
+		return res.GetFileDescriptorResponse().GetFileDescriptorProto(), nil
+	}
+}
+
+// register builds the file of path after its dependencies, the dependencies neither fetched
+// nor linked into the binary are replaced by placeholders
+func (d *dynamicDecoder) register(path string) error {
+	if _, err := d.files.FindFileByPath(path); err == nil {
+		return nil
+	}
+
+	fdp := d.protos[path]
+	for _, dep := range fdp.GetDependency() {
+		if _, ok := d.protos[dep]; ok {
+			if err := d.register(dep); err != nil {
+				return err
+			}
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, fileResolver{d.files})
+	if err != nil {
+		return err
+	}
+	return d.files.RegisterFile(fd)
+}
+
+// fileResolver resolves the files fetched from the node first, then the ones linked into the binary
+type fileResolver struct {
+	files *protoregistry.Files
+}
+
+func (r fileResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (r fileResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if desc, err := r.files.FindDescriptorByName(name); err == nil {
+		return desc, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// withTypeURL adds the "@type" field to the json object bz, the well-known types rendered to
+// a json value other than an object are put in the "value" field as the proto3 json mapping of Any
+func withTypeURL(typeURL string, bz []byte) (json.RawMessage, error) {
+	bz = bytes.TrimSpace(bz)
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("empty json of %s", typeURL)
+	}
+
+	typ, err := json.Marshal(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"@type":`)
+	buf.Write(typ)
+
+	if bz[0] != '{' {
+		buf.WriteString(`,"value":`)
+		buf.Write(bz)
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	}
+
+	if rest := bytes.TrimSpace(bz[1:]); len(rest) > 0 && rest[0] != '}' {
+		buf.WriteByte(',')
+	}
+	buf.Write(bz[1:])
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,218 @@
+package modules
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestWithTypeURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected string
+	}{
+		{"object", `{"a":1}`, `{"@type":"/a.B","a":1}`},
+		{"empty object", `{}`, `{"@type":"/a.B"}`},
+		{"empty object with spaces", "{ \n}", "{\"@type\":\"/a.B\" \n}"},
+		{"object with spaces", ` { "a": 1 } `, `{"@type":"/a.B", "a": 1 }`},
+		{"well-known value", `"1s"`, `{"@type":"/a.B","value":"1s"}`},
+		{"empty", " ", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := withTypeURL("/a.B", []byte(tc.json))
+			if tc.expected == "" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+			require.True(t, json.Valid(bz))
+		})
+	}
+}
+
+// fakeDescriptorSource serves the file descriptors of custom/v1/tx.proto, which imports custom/v1/types.proto
+// and the unknown other/v1/types.proto
+type fakeDescriptorSource struct {
+	mu    sync.Mutex
+	calls map[protoreflect.FullName]int
+	// blocks the lookups of the symbol until it is closed
+	block map[protoreflect.FullName]chan struct{}
+}
+
+func newFakeDescriptorSource() *fakeDescriptorSource {
+	return &fakeDescriptorSource{
+		calls: make(map[protoreflect.FullName]int),
+		block: make(map[protoreflect.FullName]chan struct{}),
+	}
+}
+
+func (s *fakeDescriptorSource) fetch(symbol protoreflect.FullName) ([][]byte, error) {
+	s.mu.Lock()
+	s.calls[symbol]++
+	block := s.block[symbol]
+	s.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+
+	switch symbol {
+	case "custom.v1.MsgCustom", "custom.v1.Item":
+		return marshalFiles(customTxFile(), customTypesFile())
+	default:
+		return nil, errors.New("symbol not found")
+	}
+}
+
+func (s *fakeDescriptorSource) called(symbol protoreflect.FullName) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[symbol]
+}
+
+func marshalFiles(files ...*descriptorpb.FileDescriptorProto) ([][]byte, error) {
+	var bzs [][]byte
+	for _, fdp := range files {
+		bz, err := protov2.Marshal(fdp)
+		if err != nil {
+			return nil, err
+		}
+		bzs = append(bzs, bz)
+	}
+	return bzs, nil
+}
+
+func protoField(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:     protov2.String(name),
+		JsonName: protov2.String(name),
+		Number:   protov2.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+	if typeName != "" {
+		field.TypeName = protov2.String(typeName)
+	}
+	return field
+}
+
+func customTxFile() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:       protov2.String("custom/v1/tx.proto"),
+		Package:    protov2.String("custom.v1"),
+		Syntax:     protov2.String("proto3"),
+		Dependency: []string{"custom/v1/types.proto", "other/v1/types.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: protov2.String("MsgCustom"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				protoField("sender", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				protoField("amount", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""),
+				protoField("item", 3, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".custom.v1.Item"),
+			},
+		}},
+	}
+}
+
+func customTypesFile() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    protov2.String("custom/v1/types.proto"),
+		Package: protov2.String("custom.v1"),
+		Syntax:  protov2.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  protov2.String("Item"),
+			Field: []*descriptorpb.FieldDescriptorProto{protoField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")},
+		}},
+	}
+}
+
+// customMsgBytes returns the binary encoding of a MsgCustom
+func customMsgBytes(t *testing.T, d *dynamicDecoder) []byte {
+	md, err := d.findMessage("custom.v1.MsgCustom")
+	require.NoError(t, err)
+
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("sender"), protoreflect.ValueOfString("iaa1sender"))
+	msg.Set(md.Fields().ByName("amount"), protoreflect.ValueOfUint64(10))
+	item := msg.Mutable(md.Fields().ByName("item")).Message()
+	item.Set(item.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString("item-1"))
+
+	bz, err := protov2.Marshal(msg)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestDynamicDecoderMarshalAny(t *testing.T) {
+	source := newFakeDescriptorSource()
+	d := newDynamicDecoder(source.fetch, nil, nil)
+
+	bz, err := d.marshalAny("/custom.v1.MsgCustom", customMsgBytes(t, d))
+	require.NoError(t, err)
+
+	var actual, expected interface{}
+	require.NoError(t, json.Unmarshal(bz, &actual))
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"@type": "/custom.v1.MsgCustom",
+		"sender": "iaa1sender",
+		"amount": "10",
+		"item": {"id": "item-1"}
+	}`), &expected))
+	require.Equal(t, expected, actual)
+
+	// both files are registered after one fetch, the dependency first
+	_, err = d.marshalAny("/custom.v1.Item", nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, source.called("custom.v1.MsgCustom"))
+	require.Zero(t, source.called("custom.v1.Item"))
+	require.Len(t, d.protos, 2)
+}
+
+func TestDynamicDecoderCachesMisses(t *testing.T) {
+	source := newFakeDescriptorSource()
+	d := newDynamicDecoder(source.fetch, nil, nil)
+
+	for i := 0; i < 3; i++ {
+		_, err := d.marshalAny("/unknown.v1.Msg", nil)
+		require.Error(t, err)
+	}
+	require.Equal(t, 1, source.called("unknown.v1.Msg"))
+
+	// the miss expires
+	d.missExpiration = 0
+	_, err := d.marshalAny("/unknown.v1.Msg", nil)
+	require.Error(t, err)
+	require.Equal(t, 2, source.called("unknown.v1.Msg"))
+}
+
+func TestDynamicDecoderDoesNotLockWhileFetching(t *testing.T) {
+	source := newFakeDescriptorSource()
+	d := newDynamicDecoder(source.fetch, nil, nil)
+	_, err := d.findMessage("custom.v1.MsgCustom")
+	require.NoError(t, err)
+
+	block := make(chan struct{})
+	source.block["slow.v1.Msg"] = block
+	done := make(chan error)
+	go func() {
+		_, err := d.findMessage("slow.v1.Msg")
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return source.called("slow.v1.Msg") == 1 }, time.Second, time.Millisecond)
+
+	// the known types are resolved while the fetch is pending
+	_, err = d.findMessage("custom.v1.Item")
+	require.NoError(t, err)
+
+	close(block)
+	require.Error(t, <-done)
+}
@@ -3,11 +3,15 @@ package modules
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	rpc "github.com/tendermint/tendermint/rpc/client"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
@@ -20,6 +24,8 @@ type rpcClient struct {
 	log.Logger
 	cdc       *codec.LegacyAmino
 	txDecoder sdk.TxDecoder
+	metrics   sdk.Metrics
+	tracer    trace.Tracer
 }
 
 func NewRPCClient(
@@ -28,6 +34,8 @@ func NewRPCClient(
 	txDecoder sdk.TxDecoder,
 	logger log.Logger,
 	timeout uint,
+	metrics sdk.Metrics,
+	tracer trace.Tracer,
 ) sdk.TmClient {
 	client, err := rpchttp.NewWithTimeout(remote, "/websocket", timeout)
 	if err != nil {
@@ -40,6 +48,8 @@ func NewRPCClient(
 		Logger:    logger,
 		cdc:       cdc,
 		txDecoder: txDecoder,
+		metrics:   metrics,
+		tracer:    tracer,
 	}
 }
 
@@ -122,17 +132,29 @@ func (r rpcClient) SubscribeAny(query string, handler sdk.EventHandler) (subscri
 					r.Error("unsubscribe failed", "query", subscription.Query, "subscriber", subscription.ID, "errMsg", err.Error())
 				})
 
+				_, span := r.tracer.Start(context.Background(), "SubscribeAny.handler", trace.WithAttributes(
+					attrQuery.String(subscription.Query),
+				))
+				defer span.End()
+
 				switch data := data.Data.(type) {
 				case tmtypes.EventDataTx:
-					handler(r.parseTx(data))
+					tx := r.parseTx(data)
+					span.SetAttributes(attrEvent.String(tmtypes.EventTx), attrTxHash.String(tx.Hash), attrTxHeight.Int64(tx.Height))
+					handler(tx)
 					return
 				case tmtypes.EventDataNewBlock:
+					r.metrics.EventLag(tmtypes.EventNewBlock, time.Since(data.Block.Time))
+					span.SetAttributes(attrEvent.String(tmtypes.EventNewBlock), attrTxHeight.Int64(data.Block.Height))
 					handler(r.parseNewBlock(data))
 					return
 				case tmtypes.EventDataNewBlockHeader:
+					r.metrics.EventLag(tmtypes.EventNewBlockHeader, time.Since(data.Header.Time))
+					span.SetAttributes(attrEvent.String(tmtypes.EventNewBlockHeader), attrTxHeight.Int64(data.Header.Height))
 					handler(r.parseNewBlockHeader(data))
 					return
 				case tmtypes.EventDataValidatorSetUpdates:
+					span.SetAttributes(attrEvent.String(tmtypes.EventValidatorSetUpdates))
 					handler(r.parseValidatorSetUpdates(data))
 					return
 				default:
@@ -146,12 +168,13 @@ func (r rpcClient) SubscribeAny(query string, handler sdk.EventHandler) (subscri
 
 func (r rpcClient) parseTx(data sdk.EventData) sdk.EventDataTx {
 	dataTx := data.(tmtypes.EventDataTx)
+	hash := sdk.HexBytes(tmhash.Sum(dataTx.Tx)).String()
 	tx, err := r.txDecoder(dataTx.Tx)
 	if err != nil {
+		r.Logger.Error("decode tx failed", "hash", hash, "errMsg", err.Error())
 		return sdk.EventDataTx{}
 	}
 
-	hash := sdk.HexBytes(tmhash.Sum(dataTx.Tx)).String()
 	result := sdk.TxResult{
 		Code:      dataTx.Result.Code,
 		Log:       dataTx.Result.Log,
@@ -171,7 +194,7 @@ func (r rpcClient) parseTx(data sdk.EventData) sdk.EventDataTx {
 func (r rpcClient) parseNewBlock(data sdk.EventData) sdk.EventDataNewBlock {
 	block := data.(tmtypes.EventDataNewBlock)
 	return sdk.EventDataNewBlock{
-		Block: sdk.ParseBlock(r.cdc, block.Block),
+		Block: sdk.ParseBlockWithDecoder(r.cdc, r.txDecoder, block.Block),
 		ResultBeginBlock: sdk.ResultBeginBlock{
 			Events: sdk.StringifyEvents(block.ResultBeginBlock.Events),
 		},
@@ -203,6 +226,44 @@ func (r rpcClient) parseValidatorSetUpdates(data sdk.EventData) sdk.EventDataVal
 	}
 }
 
+// blocks fetches the blocks at heights in one batch request
+func (r rpcClient) blocks(ctx context.Context, heights []int64) ([]*ctypes.ResultBlock, error) {
+	client, ok := r.Client.(*rpchttp.HTTP)
+	if !ok {
+		blocks := make([]*ctypes.ResultBlock, len(heights))
+		for i := range heights {
+			block, err := r.Block(ctx, &heights[i])
+			if err != nil {
+				return nil, err
+			}
+			blocks[i] = block
+		}
+		return blocks, nil
+	}
+
+	batch := client.NewBatch()
+	for i := range heights {
+		if _, err := batch.Block(ctx, &heights[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := batch.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*ctypes.ResultBlock, len(results))
+	for i, res := range results {
+		block, ok := res.(*ctypes.ResultBlock)
+		if !ok {
+			return nil, fmt.Errorf("unexpected result %T of block %d", res, heights[i])
+		}
+		blocks[i] = block
+	}
+	return blocks, nil
+}
+
 func getSubscriber() string {
 	subscriber := "irishub-sdk-go"
 	id, err := uuid.NewV1()
@@ -0,0 +1,189 @@
+package modules
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// sequenceMismatchRegex matches the log of the sequence mismatch error returned by the ante handler
+var sequenceMismatchRegex = regexp.MustCompile(`expected (\d+), got (\d+)`)
+
+// sequenceManager hands out the sequences of the local accounts atomically, so that
+// concurrent transactions of one account can be signed and broadcast without waiting
+// for each other to be committed.
+//
+// Every sequence returned by acquire must be settled by exactly one of confirm,
+// release or mismatch once the result of the broadcast is known.
+//
+// If caching is disabled, the account is synced from the chain whenever none of its
+// sequences is in flight, as if it had expired.
+type sequenceManager struct {
+	mu       sync.Mutex
+	accounts map[string]*accountSequence
+
+	query      func(ctx context.Context, address string) (sdk.BaseAccount, sdk.Error)
+	expiration time.Duration
+	metrics    sdk.Metrics
+	logger     log.Logger
+}
+
+type accountSequence struct {
+	mu            sync.Mutex
+	synced        bool
+	accountNumber uint64
+	next          uint64
+	pending       map[uint64]struct{}
+	lastUsed      time.Time
+	// next was set to the sequence expected by the node, the chain is not queried
+	// by the next acquire even if the account is idle
+	expected bool
+}
+
+func newSequenceManager(query func(ctx context.Context, address string) (sdk.BaseAccount, sdk.Error),
+	cached bool, expiration time.Duration, metrics sdk.Metrics, logger log.Logger) *sequenceManager {
+	if !cached {
+		expiration = 0
+	}
+	return &sequenceManager{
+		accounts:   make(map[string]*accountSequence),
+		query:      query,
+		expiration: expiration,
+		metrics:    metrics,
+		logger:     logger,
+	}
+}
+
+func (m *sequenceManager) account(address string) *accountSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[address]
+	if !ok {
+		acc = &accountSequence{pending: make(map[uint64]struct{})}
+		m.accounts[address] = acc
+	}
+	return acc
+}
+
+// acquire returns the account number and the next sequence of the account, the local state
+// is synced from the chain when the account is unknown or has been idle longer than the expiration.
+func (m *sequenceManager) acquire(ctx context.Context, address string) (uint64, uint64, sdk.Error) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	idle := len(acc.pending) == 0 && !acc.expected &&
+		(m.expiration == 0 || time.Since(acc.lastUsed) > m.expiration)
+	m.metrics.CacheAccess("account", acc.synced && !idle)
+	if !acc.synced || idle {
+		account, err := m.query(ctx, address)
+		if err != nil {
+			return 0, 0, err
+		}
+		acc.accountNumber = account.AccountNumber
+		acc.next = account.Sequence
+		acc.synced = true
+		m.logger.Debug("sync account sequence from chain", "address", address, "sequence", acc.next)
+	}
+
+	sequence := acc.next
+	acc.next++
+	acc.expected = false
+	acc.pending[sequence] = struct{}{}
+	acc.lastUsed = time.Now()
+	return acc.accountNumber, sequence, nil
+}
+
+// confirm marks the sequence as consumed, the transaction has been accepted by the node
+// or may have been, e.g. the broadcast timed out.
+func (m *sequenceManager) confirm(address string, sequence uint64) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	delete(acc.pending, sequence)
+}
+
+// release gives back a sequence which has not been consumed, e.g. the transaction was rejected
+// by CheckTx or never broadcast. The next sequence is rewound to it only if it is the highest one
+// handed out, the transactions of the following sequences are still in flight and rewinding would
+// hand their sequences out twice; they are rejected for a sequence mismatch, which resyncs the account.
+func (m *sequenceManager) release(address string, sequence uint64) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	// the sequence has been settled by a resync already
+	if _, ok := acc.pending[sequence]; !ok {
+		return
+	}
+
+	delete(acc.pending, sequence)
+	if sequence+1 == acc.next {
+		acc.next = sequence
+	}
+}
+
+// mismatch resyncs the account after the transaction signed with sequence was rejected for a
+// sequence mismatch. The expected sequence is parsed from the log of err, the state is synced
+// from the chain if it can not be.
+func (m *sequenceManager) mismatch(address string, sequence uint64, err sdk.Error) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	delete(acc.pending, sequence)
+
+	expected, ok := parseExpectedSequence(err)
+	switch {
+	case !ok:
+		// the next acquire queries the chain
+		acc.synced = false
+	case expected > sequence:
+		// the account was used by someone else
+		if expected > acc.next {
+			acc.next = expected
+		}
+		for s := range acc.pending {
+			if s < expected {
+				delete(acc.pending, s)
+			}
+		}
+	default:
+		_, inflight := acc.pending[expected]
+		if !inflight {
+			// a transaction with the expected sequence was lost, e.g. an async broadcast failed
+			sequence = expected
+		}
+		if sequence < acc.next {
+			acc.next = sequence
+		}
+	}
+	acc.expected = ok
+	m.logger.Debug("account sequence mismatch", "address", address, "sequence", sequence, "next", acc.next)
+}
+
+func parseExpectedSequence(err sdk.Error) (uint64, bool) {
+	log := err.RawLog()
+	if len(log) == 0 {
+		log = err.Error()
+	}
+
+	matches := sequenceMismatchRegex.FindStringSubmatch(log)
+	if len(matches) != 3 {
+		return 0, false
+	}
+
+	expected, e := strconv.ParseUint(matches[1], 10, 64)
+	if e != nil {
+		return 0, false
+	}
+	return expected, true
+}
@@ -0,0 +1,208 @@
+package modules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const testAddress = "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z"
+
+// fakeAccounts is the account querier of the sequence manager
+type fakeAccounts struct {
+	sequence uint64
+	queries  int
+}
+
+func (f *fakeAccounts) query(_ context.Context, address string) (sdk.BaseAccount, sdk.Error) {
+	f.queries++
+	return sdk.BaseAccount{Address: address, AccountNumber: 1, Sequence: f.sequence}, nil
+}
+
+// sequenceOp is one step applied to the sequence manager
+type sequenceOp func(t *testing.T, m *sequenceManager, chain *fakeAccounts)
+
+func acquireOp(want uint64) sequenceOp {
+	return func(t *testing.T, m *sequenceManager, _ *fakeAccounts) {
+		accountNumber, sequence, err := m.acquire(context.Background(), testAddress)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), accountNumber)
+		require.Equal(t, want, sequence)
+	}
+}
+
+func confirmOp(sequence uint64) sequenceOp {
+	return func(_ *testing.T, m *sequenceManager, _ *fakeAccounts) {
+		m.confirm(testAddress, sequence)
+	}
+}
+
+func releaseOp(sequence uint64) sequenceOp {
+	return func(_ *testing.T, m *sequenceManager, _ *fakeAccounts) {
+		m.release(testAddress, sequence)
+	}
+}
+
+func mismatchOp(sequence uint64, log string) sequenceOp {
+	return func(_ *testing.T, m *sequenceManager, _ *fakeAccounts) {
+		m.mismatch(testAddress, sequence, sdk.GetTxError("hash", sdk.RootCodespace, 32, log))
+	}
+}
+
+// chainOp sets the sequence of the account on chain
+func chainOp(sequence uint64) sequenceOp {
+	return func(_ *testing.T, _ *sequenceManager, chain *fakeAccounts) {
+		chain.sequence = sequence
+	}
+}
+
+func TestSequenceManager(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cached  bool
+		ops     []sequenceOp
+		queries int
+	}{
+		{
+			name:    "consecutive sequences",
+			cached:  true,
+			ops:     []sequenceOp{acquireOp(5), acquireOp(6), acquireOp(7)},
+			queries: 1,
+		},
+		{
+			name:    "confirmed sequence is consumed",
+			cached:  true,
+			ops:     []sequenceOp{acquireOp(5), confirmOp(5), chainOp(9), acquireOp(6)},
+			queries: 1,
+		},
+		{
+			name:    "release of the highest sequence rewinds",
+			cached:  true,
+			ops:     []sequenceOp{acquireOp(5), acquireOp(6), releaseOp(6), acquireOp(6)},
+			queries: 1,
+		},
+		{
+			name:    "release below an in-flight sequence does not rewind",
+			cached:  true,
+			ops:     []sequenceOp{acquireOp(5), acquireOp(6), releaseOp(5), acquireOp(7)},
+			queries: 1,
+		},
+		{
+			name:    "release of a settled sequence is ignored",
+			cached:  true,
+			ops:     []sequenceOp{acquireOp(5), confirmOp(5), releaseOp(5), acquireOp(6)},
+			queries: 1,
+		},
+		{
+			name:   "mismatch with a higher expected sequence",
+			cached: true,
+			ops: []sequenceOp{
+				acquireOp(5), acquireOp(6),
+				mismatchOp(5, "account sequence mismatch, expected 8, got 5: incorrect account sequence"),
+				acquireOp(8),
+			},
+			queries: 1,
+		},
+		{
+			name:   "mismatch with a lost lower sequence",
+			cached: true,
+			ops: []sequenceOp{
+				acquireOp(5), acquireOp(6), confirmOp(5),
+				mismatchOp(6, "account sequence mismatch, expected 5, got 6: incorrect account sequence"),
+				acquireOp(5),
+			},
+			queries: 1,
+		},
+		{
+			name:   "mismatch with an in-flight lower sequence",
+			cached: true,
+			ops: []sequenceOp{
+				acquireOp(5), acquireOp(6), acquireOp(7),
+				mismatchOp(7, "account sequence mismatch, expected 6, got 7: incorrect account sequence"),
+				acquireOp(7),
+			},
+			queries: 1,
+		},
+		{
+			name:   "unparsable mismatch resyncs",
+			cached: true,
+			ops: []sequenceOp{
+				acquireOp(5), chainOp(9),
+				mismatchOp(5, "incorrect account sequence"),
+				acquireOp(9),
+			},
+			queries: 2,
+		},
+		{
+			name:    "uncached resyncs when no sequence is in flight",
+			cached:  false,
+			ops:     []sequenceOp{acquireOp(5), confirmOp(5), chainOp(6), acquireOp(6), acquireOp(7)},
+			queries: 2,
+		},
+		{
+			name:   "uncached keeps the sequence expected by the node",
+			cached: false,
+			ops: []sequenceOp{
+				acquireOp(5),
+				mismatchOp(5, "account sequence mismatch, expected 7, got 5: incorrect account sequence"),
+				acquireOp(7), confirmOp(7), acquireOp(5),
+			},
+			queries: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := &fakeAccounts{sequence: 5}
+			m := newSequenceManager(chain.query, tc.cached, time.Minute, sdk.NopMetrics(), log.NewNopLogger())
+			for _, op := range tc.ops {
+				op(t, m, chain)
+			}
+			require.Equal(t, tc.queries, chain.queries)
+		})
+	}
+}
+
+func TestParseExpectedSequence(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      sdk.Error
+		expected uint64
+		ok       bool
+	}{
+		{
+			name:     "raw log",
+			err:      sdk.GetTxError("hash", sdk.RootCodespace, 32, "account sequence mismatch, expected 12, got 10: incorrect account sequence"),
+			expected: 12,
+			ok:       true,
+		},
+		{
+			name:     "message",
+			err:      sdk.Wrapf("account sequence mismatch, expected 3, got 4"),
+			expected: 3,
+			ok:       true,
+		},
+		{
+			name: "no sequence",
+			err:  sdk.GetTxError("hash", sdk.RootCodespace, 32, "incorrect account sequence"),
+		},
+		{
+			name: "overflow",
+			err:  sdk.GetTxError("hash", sdk.RootCodespace, 32, "expected 99999999999999999999, got 1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expected, ok := parseExpectedSequence(tc.err)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, expected)
+		})
+	}
+}
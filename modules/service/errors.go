@@ -0,0 +1,49 @@
+package service
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the service module of irishub, use errors.Is to match them
+var (
+	ErrInvalidServiceName        = sdk.Register(ModuleName, 2, "invalid service name")
+	ErrInvalidDescription        = sdk.Register(ModuleName, 3, "invalid description")
+	ErrInvalidTags               = sdk.Register(ModuleName, 4, "invalid tags")
+	ErrInvalidSchemas            = sdk.Register(ModuleName, 5, "invalid schemas")
+	ErrUnknownServiceDefinition  = sdk.Register(ModuleName, 6, "unknown service definition")
+	ErrServiceDefinitionExists   = sdk.Register(ModuleName, 7, "service definition already exists")
+	ErrInvalidDeposit            = sdk.Register(ModuleName, 8, "invalid deposit")
+	ErrInvalidMinDeposit         = sdk.Register(ModuleName, 9, "invalid minimum deposit")
+	ErrInvalidPricing            = sdk.Register(ModuleName, 10, "invalid pricing")
+	ErrInvalidQoS                = sdk.Register(ModuleName, 11, "invalid QoS")
+	ErrServiceBindingExists      = sdk.Register(ModuleName, 12, "service binding already exists")
+	ErrUnknownServiceBinding     = sdk.Register(ModuleName, 13, "unknown service binding")
+	ErrServiceBindingUnavailable = sdk.Register(ModuleName, 14, "service binding unavailable")
+	ErrServiceBindingAvailable   = sdk.Register(ModuleName, 15, "service binding available")
+	ErrIncorrectRefundTime       = sdk.Register(ModuleName, 16, "incorrect refund time")
+	ErrInvalidServiceFeeCap      = sdk.Register(ModuleName, 17, "invalid service fee cap")
+	ErrInvalidProviders          = sdk.Register(ModuleName, 18, "invalid providers")
+	ErrInvalidTimeout            = sdk.Register(ModuleName, 19, "invalid timeout")
+	ErrInvalidRepeatedFreq       = sdk.Register(ModuleName, 20, "invalid repeated frequency")
+	ErrInvalidRepeatedTotal      = sdk.Register(ModuleName, 21, "invalid repeated total count")
+	ErrInvalidResponseThreshold  = sdk.Register(ModuleName, 22, "invalid response threshold")
+	ErrInvalidResponse           = sdk.Register(ModuleName, 23, "invalid response")
+	ErrInvalidRequestID          = sdk.Register(ModuleName, 24, "invalid request ID")
+	ErrUnknownRequest            = sdk.Register(ModuleName, 25, "unknown request")
+	ErrUnknownResponse           = sdk.Register(ModuleName, 26, "unknown response")
+	ErrUnknownRequestContext     = sdk.Register(ModuleName, 27, "unknown request context")
+	ErrInvalidRequestContextID   = sdk.Register(ModuleName, 28, "invalid request context ID")
+	ErrRequestContextNonRepeated = sdk.Register(ModuleName, 29, "request context non repeated")
+	ErrRequestContextNotRunning  = sdk.Register(ModuleName, 30, "request context not running")
+	ErrRequestContextNotPaused   = sdk.Register(ModuleName, 31, "request context not paused")
+	ErrRequestContextCompleted   = sdk.Register(ModuleName, 32, "request context completed")
+	ErrCallbackRegistered        = sdk.Register(ModuleName, 33, "callback registered")
+	ErrCallbackNotRegistered     = sdk.Register(ModuleName, 34, "callback not registered")
+	ErrNoEarnedFees              = sdk.Register(ModuleName, 35, "no earned fees")
+	ErrInvalidRequestInput       = sdk.Register(ModuleName, 36, "invalid request input")
+	ErrInvalidResponseOutput     = sdk.Register(ModuleName, 37, "invalid response output")
+	ErrInvalidResponseResult     = sdk.Register(ModuleName, 38, "invalid response result")
+	ErrInvalidSchemaName         = sdk.Register(ModuleName, 39, "invalid service schema name")
+	ErrNotAuthorized             = sdk.Register(ModuleName, 40, "not authorized")
+	ErrModuleServiceRegistered   = sdk.Register(ModuleName, 41, "module service registered")
+)
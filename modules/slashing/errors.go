@@ -0,0 +1,16 @@
+package slashing
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the slashing module of irishub, use errors.Is to match them
+var (
+	ErrNoValidatorForAddress        = sdk.Register(ModuleName, 2, "address is not associated with any known validator")
+	ErrBadValidatorAddr             = sdk.Register(ModuleName, 3, "validator does not exist for that address")
+	ErrValidatorJailed              = sdk.Register(ModuleName, 4, "validator still jailed; cannot be unjailed")
+	ErrValidatorNotJailed           = sdk.Register(ModuleName, 5, "validator not jailed; cannot be unjailed")
+	ErrMissingSelfDelegation        = sdk.Register(ModuleName, 6, "validator has no self-delegation; cannot be unjailed")
+	ErrSelfDelegationTooLowToUnjail = sdk.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
+	ErrNoSigningInfoFound           = sdk.Register(ModuleName, 8, "no validator signing info found")
+)
@@ -0,0 +1,40 @@
+package slashing
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Slashing module api for user
+type Client interface {
+	sdk.Module
+
+	Unjail(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QuerySigningInfo(consAddr string) (QuerySigningInfoResp, sdk.Error)
+	QuerySigningInfos(page, size uint64) (QuerySigningInfosResp, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
+}
+
+type QuerySigningInfoResp struct {
+	Address             string    `json:"address"`
+	StartHeight         int64     `json:"start_height"`
+	IndexOffset         int64     `json:"index_offset"`
+	JailedUntil         time.Time `json:"jailed_until"`
+	Tombstoned          bool      `json:"tombstoned"`
+	MissedBlocksCounter int64     `json:"missed_blocks_counter"`
+}
+
+type QuerySigningInfosResp struct {
+	SigningInfos []QuerySigningInfoResp `json:"signing_infos"`
+	Total        uint64                 `json:"total"`
+}
+
+type QueryParamsResp struct {
+	SignedBlocksWindow      int64         `json:"signed_blocks_window"`
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime"`
+}
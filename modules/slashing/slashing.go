@@ -0,0 +1,107 @@
+package slashing
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type slashingClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return slashingClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (sc slashingClient) Name() string {
+	return ModuleName
+}
+
+func (sc slashingClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+// Unjail unjails the validator operated by baseTx.From
+func (sc slashingClient) Unjail(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	operator, err := sc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgUnjail{
+		ValidatorAddr: sdk.ValAddress(operator).String(),
+	}
+	return sc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// QuerySigningInfo returns the signing info of the validator with the consensus address consAddr
+func (sc slashingClient) QuerySigningInfo(consAddr string) (QuerySigningInfoResp, sdk.Error) {
+	if len(consAddr) == 0 {
+		return QuerySigningInfoResp{}, sdk.Wrapf("consensus address is required")
+	}
+
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QuerySigningInfoResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).SigningInfo(
+		context.Background(),
+		&QuerySigningInfoRequest{ConsAddress: consAddr},
+	)
+	if err != nil {
+		return QuerySigningInfoResp{}, sdk.Wrap(err)
+	}
+	return res.ValSigningInfo.Convert().(QuerySigningInfoResp), nil
+}
+
+func (sc slashingClient) QuerySigningInfos(page, size uint64) (QuerySigningInfosResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QuerySigningInfosResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).SigningInfos(
+		context.Background(),
+		&QuerySigningInfosRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QuerySigningInfosResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QuerySigningInfosResp), nil
+}
+
+func (sc slashingClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Params(
+		context.Background(),
+		&QueryParamsRequest{},
+	)
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Params.Convert().(QueryParamsResp), nil
+}
@@ -0,0 +1,76 @@
+package slashing
+
+import (
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "slashing"
+)
+
+var (
+	_ sdk.Msg = &MsgUnjail{}
+)
+
+func (msg MsgUnjail) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgUnjail) Type() string { return "unjail" }
+
+// ValidateBasic implements Msg
+func (msg MsgUnjail) ValidateBasic() error {
+	if msg.ValidatorAddr == "" {
+		return sdk.Wrapf("missing validator address")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	validator, _ := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	return []sdk.AccAddress{sdk.AccAddress(validator)}
+}
+
+func (i ValidatorSigningInfo) String() string {
+	out, _ := yaml.Marshal(i)
+	return string(out)
+}
+
+func (i ValidatorSigningInfo) Convert() interface{} {
+	return QuerySigningInfoResp{
+		Address:             i.Address,
+		StartHeight:         i.StartHeight,
+		IndexOffset:         i.IndexOffset,
+		JailedUntil:         i.JailedUntil,
+		Tombstoned:          i.Tombstoned,
+		MissedBlocksCounter: i.MissedBlocksCounter,
+	}
+}
+
+func (q QuerySigningInfosResponse) Convert() interface{} {
+	res := QuerySigningInfosResp{
+		Total: q.Pagination.Total,
+	}
+	for _, info := range q.Info {
+		res.SigningInfos = append(res.SigningInfos, info.Convert().(QuerySigningInfoResp))
+	}
+	return res
+}
+
+func (p Params) Convert() interface{} {
+	return QueryParamsResp{
+		SignedBlocksWindow:      p.SignedBlocksWindow,
+		MinSignedPerWindow:      p.MinSignedPerWindow,
+		DowntimeJailDuration:    p.DowntimeJailDuration,
+		SlashFractionDoubleSign: p.SlashFractionDoubleSign,
+		SlashFractionDowntime:   p.SlashFractionDowntime,
+	}
+}
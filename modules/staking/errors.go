@@ -0,0 +1,55 @@
+package staking
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the staking module of irishub, use errors.Is to match them
+var (
+	ErrEmptyValidatorAddr              = sdk.Register(ModuleName, 2, "empty validator address")
+	ErrBadValidatorAddr                = sdk.Register(ModuleName, 3, "validator address is invalid")
+	ErrNoValidatorFound                = sdk.Register(ModuleName, 4, "validator does not exist")
+	ErrValidatorOwnerExists            = sdk.Register(ModuleName, 5, "validator already exist for this operator address; must use new validator operator address")
+	ErrValidatorPubKeyExists           = sdk.Register(ModuleName, 6, "validator already exist for this pubkey; must use new validator pubkey")
+	ErrValidatorPubKeyTypeNotSupported = sdk.Register(ModuleName, 7, "validator pubkey type is not supported")
+	ErrValidatorJailed                 = sdk.Register(ModuleName, 8, "validator for this address is currently jailed")
+	ErrBadRemoveValidator              = sdk.Register(ModuleName, 9, "failed to remove validator")
+	ErrCommissionNegative              = sdk.Register(ModuleName, 10, "commission must be positive")
+	ErrCommissionHuge                  = sdk.Register(ModuleName, 11, "commission cannot be more than 100%")
+	ErrCommissionGTMaxRate             = sdk.Register(ModuleName, 12, "commission cannot be more than the max rate")
+	ErrCommissionUpdateTime            = sdk.Register(ModuleName, 13, "commission cannot be changed more than once in 24h")
+	ErrCommissionChangeRateNegative    = sdk.Register(ModuleName, 14, "commission change rate must be positive")
+	ErrCommissionChangeRateGTMaxRate   = sdk.Register(ModuleName, 15, "commission change rate cannot be more than the max rate")
+	ErrCommissionGTMaxChangeRate       = sdk.Register(ModuleName, 16, "commission cannot be changed more than max change rate")
+	ErrSelfDelegationBelowMinimum      = sdk.Register(ModuleName, 17, "validator's self delegation must be greater than their minimum self delegation")
+	ErrMinSelfDelegationInvalid        = sdk.Register(ModuleName, 18, "minimum self delegation must be a positive integer")
+	ErrMinSelfDelegationDecreased      = sdk.Register(ModuleName, 19, "minimum self delegation cannot be decrease")
+	ErrEmptyDelegatorAddr              = sdk.Register(ModuleName, 20, "empty delegator address")
+	ErrBadDenom                        = sdk.Register(ModuleName, 21, "invalid coin denomination")
+	ErrBadDelegationAddr               = sdk.Register(ModuleName, 22, "invalid address for (address, validator) tuple")
+	ErrBadDelegationAmount             = sdk.Register(ModuleName, 23, "invalid delegation amount")
+	ErrNoDelegation                    = sdk.Register(ModuleName, 24, "no delegation for (address, validator) tuple")
+	ErrBadDelegatorAddr                = sdk.Register(ModuleName, 25, "delegator does not exist with address")
+	ErrNoDelegatorForAddress           = sdk.Register(ModuleName, 26, "delegator does not contain delegation")
+	ErrInsufficientShares              = sdk.Register(ModuleName, 27, "insufficient delegation shares")
+	ErrDelegationValidatorEmpty        = sdk.Register(ModuleName, 28, "cannot delegate to an empty validator")
+	ErrNotEnoughDelegationShares       = sdk.Register(ModuleName, 29, "not enough delegation shares")
+	ErrBadSharesAmount                 = sdk.Register(ModuleName, 30, "invalid shares amount")
+	ErrBadSharesPercent                = sdk.Register(ModuleName, 31, "Invalid shares percent")
+	ErrNotMature                       = sdk.Register(ModuleName, 32, "entry not mature")
+	ErrNoUnbondingDelegation           = sdk.Register(ModuleName, 33, "no unbonding delegation found")
+	ErrMaxUnbondingDelegationEntries   = sdk.Register(ModuleName, 34, "too many unbonding delegation entries for (delegator, validator) tuple")
+	ErrBadRedelegationAddr             = sdk.Register(ModuleName, 35, "invalid address for (address, src-validator, dst-validator) tuple")
+	ErrNoRedelegation                  = sdk.Register(ModuleName, 36, "no redelegation found")
+	ErrSelfRedelegation                = sdk.Register(ModuleName, 37, "cannot redelegate to the same validator")
+	ErrTinyRedelegationAmount          = sdk.Register(ModuleName, 38, "too few tokens to redelegate (truncates to zero tokens)")
+	ErrBadRedelegationDst              = sdk.Register(ModuleName, 39, "redelegation destination validator not found")
+	ErrTransitiveRedelegation          = sdk.Register(ModuleName, 40, "redelegation to this validator already in progress; first redelegation to this validator must complete before next redelegation")
+	ErrMaxRedelegationEntries          = sdk.Register(ModuleName, 41, "too many redelegation entries for (delegator, src-validator, dst-validator) tuple")
+	ErrDelegatorShareExRateInvalid     = sdk.Register(ModuleName, 42, "cannot delegate to validators with invalid (zero) ex-rate")
+	ErrBothShareMsgsGiven              = sdk.Register(ModuleName, 43, "both shares amount and shares percent provided")
+	ErrNeitherShareMsgsGiven           = sdk.Register(ModuleName, 44, "neither shares amount nor shares percent provided")
+	ErrInvalidHistoricalInfo           = sdk.Register(ModuleName, 45, "invalid historical info")
+	ErrNoHistoricalInfo                = sdk.Register(ModuleName, 46, "no historical info found")
+	ErrEmptyValidatorPubKey            = sdk.Register(ModuleName, 47, "empty validator public key")
+)
@@ -29,6 +29,8 @@ type Client interface {
 	QueryHistoricalInfo(height int64) (QueryHistoricalInfoResp, sdk.Error)
 	QueryPool() (QueryPoolResp, sdk.Error)
 	QueryParams() (QueryParamsResp, sdk.Error)
+
+	Portfolio(delegatorAddr string) (PortfolioResp, sdk.Error)
 }
 
 type CreateValidatorRequest struct {
@@ -124,7 +126,7 @@ type (
 )
 
 type (
-	unbondingDelegationEntry struct {
+	UnbondingDelegationEntryResp struct {
 		CreationHeight int64     `json:"creation_height"`
 		CompletionTime time.Time `json:"completion_time"`
 		InitialBalance sdk.Int   `json:"initial_balance"`
@@ -132,9 +134,9 @@ type (
 	}
 
 	QueryUnbondingDelegationResp struct {
-		DelegatorAddress string                     `json:"delegator_address"`
-		ValidatorAddress string                     `json:"validator_address"`
-		Entries          []unbondingDelegationEntry `json:"entries"`
+		DelegatorAddress string                         `json:"delegator_address"`
+		ValidatorAddress string                         `json:"validator_address"`
+		Entries          []UnbondingDelegationEntryResp `json:"entries"`
 	}
 
 	QueryValidatorUnbondingDelegationsResp struct {
@@ -167,21 +169,21 @@ type (
 		Total                 uint64             `json:"total"`
 	}
 
-	redelegationEntry struct {
+	RedelegationEntryResp struct {
 		CreationHeight int64     `json:"creation_height"`
 		CompletionTime time.Time `json:"completion_time"`
 		InitialBalance sdk.Int   `json:"initial_balance"`
 		SharesDst      sdk.Dec   `json:"shares_dst"`
 	}
 	redelegationEntryResponse struct {
-		RedelegationEntry redelegationEntry `json:"redelegation_entry"`
-		Balance           sdk.Int           `json:"balance"`
+		RedelegationEntry RedelegationEntryResp `json:"redelegation_entry"`
+		Balance           sdk.Int               `json:"balance"`
 	}
 	redelegation struct {
-		DelegatorAddress    string              `json:"delegator_address"`
-		ValidatorSrcAddress string              `json:"validator_src_address"`
-		ValidatorDstAddress string              `json:"validator_dst_address"`
-		Entries             []redelegationEntry `json:"entries"`
+		DelegatorAddress    string                  `json:"delegator_address"`
+		ValidatorSrcAddress string                  `json:"validator_src_address"`
+		ValidatorDstAddress string                  `json:"validator_dst_address"`
+		Entries             []RedelegationEntryResp `json:"entries"`
 	}
 
 	RedelegationResp struct {
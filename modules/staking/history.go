@@ -0,0 +1,68 @@
+package staking
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var _ sdk.HistoryModule = stakingClient{}
+
+// DelegateActivity is the activity of a MsgDelegate
+type DelegateActivity struct {
+	Delegator string   `json:"delegator"`
+	Validator string   `json:"validator"`
+	Amount    sdk.Coin `json:"amount"`
+}
+
+func (a DelegateActivity) Module() string { return ModuleName }
+func (a DelegateActivity) Action() string { return "delegate" }
+
+// UndelegateActivity is the activity of a MsgUndelegate
+type UndelegateActivity struct {
+	Delegator string   `json:"delegator"`
+	Validator string   `json:"validator"`
+	Amount    sdk.Coin `json:"amount"`
+}
+
+func (a UndelegateActivity) Module() string { return ModuleName }
+func (a UndelegateActivity) Action() string { return "begin_unbonding" }
+
+// RedelegateActivity is the activity of a MsgBeginRedelegate
+type RedelegateActivity struct {
+	Delegator    string   `json:"delegator"`
+	SrcValidator string   `json:"src_validator"`
+	DstValidator string   `json:"dst_validator"`
+	Amount       sdk.Coin `json:"amount"`
+}
+
+func (a RedelegateActivity) Module() string { return ModuleName }
+func (a RedelegateActivity) Action() string { return "begin_redelegate" }
+
+// HistoryQueries returns no query, the staking txs of a delegator are sent by itself
+func (sc stakingClient) HistoryQueries(address string) []*sdk.EventQueryBuilder {
+	return nil
+}
+
+func (sc stakingClient) ParseActivity(msg sdk.Msg) (sdk.Activity, bool) {
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		return DelegateActivity{
+			Delegator: msg.DelegatorAddress,
+			Validator: msg.ValidatorAddress,
+			Amount:    msg.Amount,
+		}, true
+	case *MsgUndelegate:
+		return UndelegateActivity{
+			Delegator: msg.DelegatorAddress,
+			Validator: msg.ValidatorAddress,
+			Amount:    msg.Amount,
+		}, true
+	case *MsgBeginRedelegate:
+		return RedelegateActivity{
+			Delegator:    msg.DelegatorAddress,
+			SrcValidator: msg.ValidatorSrcAddress,
+			DstValidator: msg.ValidatorDstAddress,
+			Amount:       msg.Amount,
+		}, true
+	}
+	return nil, false
+}
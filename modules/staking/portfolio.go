@@ -0,0 +1,306 @@
+package staking
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+)
+
+// portfolioPageSize is the size of the pages fetched by Portfolio
+const portfolioPageSize = 100
+
+type (
+	// PortfolioValidator is the metadata of a validator of a portfolio
+	PortfolioValidator struct {
+		OperatorAddress string  `json:"operator_address"`
+		Moniker         string  `json:"moniker"`
+		Status          string  `json:"status"`
+		Jailed          bool    `json:"jailed"`
+		CommissionRate  sdk.Dec `json:"commission_rate"`
+		// tokens per share of the validator
+		ExchangeRate sdk.Dec `json:"exchange_rate"`
+	}
+
+	PortfolioDelegation struct {
+		Validator PortfolioValidator `json:"validator"`
+		Shares    sdk.Dec            `json:"shares"`
+		// the shares converted to tokens with the exchange rate of the validator
+		Tokens sdk.Coin     `json:"tokens"`
+		Reward sdk.DecCoins `json:"reward"`
+	}
+
+	PortfolioUnbonding struct {
+		Validator PortfolioValidator             `json:"validator"`
+		Entries   []UnbondingDelegationEntryResp `json:"entries"`
+		Balance   sdk.Coin                       `json:"balance"`
+	}
+
+	PortfolioRedelegationEntry struct {
+		RedelegationEntryResp
+		// the destination shares converted to tokens with the exchange rate of the destination validator
+		Tokens sdk.Coin `json:"tokens"`
+	}
+
+	PortfolioRedelegation struct {
+		SrcValidator PortfolioValidator           `json:"src_validator"`
+		DstValidator PortfolioValidator           `json:"dst_validator"`
+		Entries      []PortfolioRedelegationEntry `json:"entries"`
+	}
+
+	// PortfolioAmount is an amount in both min and main units
+	PortfolioAmount struct {
+		Min  sdk.Coins    `json:"min"`
+		Main sdk.DecCoins `json:"main"`
+	}
+
+	// PortfolioTotals sums the portfolio, the redelegating tokens are also part of the delegated ones
+	PortfolioTotals struct {
+		Delegated    PortfolioAmount `json:"delegated"`
+		Unbonding    PortfolioAmount `json:"unbonding"`
+		Redelegating PortfolioAmount `json:"redelegating"`
+		Rewards      PortfolioAmount `json:"rewards"`
+	}
+
+	PortfolioResp struct {
+		Delegator     string                  `json:"delegator"`
+		Delegations   []PortfolioDelegation   `json:"delegations"`
+		Unbondings    []PortfolioUnbonding    `json:"unbondings"`
+		Redelegations []PortfolioRedelegation `json:"redelegations"`
+		Totals        PortfolioTotals         `json:"totals"`
+	}
+)
+
+// Portfolio returns the whole staking position of the delegator: its delegations with their rewards, unbondings and
+// redelegations, all of them paged through, joined with the validators and summed in min and main units
+func (sc stakingClient) Portfolio(delegatorAddr string) (PortfolioResp, sdk.Error) {
+	if _, err := sdk.AccAddressFromBech32(delegatorAddr); err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+
+	p := portfolio{
+		client:     NewQueryClient(conn),
+		validators: make(map[string]Validator),
+	}
+
+	params, err := p.client.Params(context.Background(), &QueryParamsRequest{})
+	if err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+	bondDenom := params.Params.BondDenom
+
+	res := PortfolioResp{Delegator: delegatorAddr}
+	var delegated, unbonding, redelegating sdk.Coins
+
+	rewards, err := distribution.NewQueryClient(conn).DelegationTotalRewards(
+		context.Background(),
+		&distribution.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddr},
+	)
+	if err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+	rewardsByValidator := make(map[string]sdk.DecCoins, len(rewards.Rewards))
+	for _, r := range rewards.Rewards {
+		rewardsByValidator[r.ValidatorAddress] = r.Reward
+	}
+
+	delegations, err := p.delegations(delegatorAddr)
+	if err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+	for _, d := range delegations {
+		validator, err := p.validator(d.Delegation.ValidatorAddress)
+		if err != nil {
+			return PortfolioResp{}, sdk.Wrap(err)
+		}
+
+		tokens := sdk.NewCoin(bondDenom, tokensFromShares(validator, d.Delegation.Shares))
+		delegated = delegated.Add(tokens)
+		res.Delegations = append(res.Delegations, PortfolioDelegation{
+			Validator: newPortfolioValidator(validator),
+			Shares:    d.Delegation.Shares,
+			Tokens:    tokens,
+			Reward:    rewardsByValidator[d.Delegation.ValidatorAddress],
+		})
+	}
+
+	unbondings, err := p.unbondings(delegatorAddr)
+	if err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+	for _, u := range unbondings {
+		validator, err := p.validator(u.ValidatorAddress)
+		if err != nil {
+			return PortfolioResp{}, sdk.Wrap(err)
+		}
+
+		entry := PortfolioUnbonding{
+			Validator: newPortfolioValidator(validator),
+			Balance:   sdk.NewCoin(bondDenom, sdk.ZeroInt()),
+		}
+		for _, e := range u.Entries {
+			entry.Balance = entry.Balance.Add(sdk.NewCoin(bondDenom, e.Balance))
+			entry.Entries = append(entry.Entries, e.Convert().(UnbondingDelegationEntryResp))
+		}
+		unbonding = unbonding.Add(entry.Balance)
+		res.Unbondings = append(res.Unbondings, entry)
+	}
+
+	redelegations, err := p.redelegations(delegatorAddr)
+	if err != nil {
+		return PortfolioResp{}, sdk.Wrap(err)
+	}
+	for _, r := range redelegations {
+		src, err := p.validator(r.Redelegation.ValidatorSrcAddress)
+		if err != nil {
+			return PortfolioResp{}, sdk.Wrap(err)
+		}
+		dst, err := p.validator(r.Redelegation.ValidatorDstAddress)
+		if err != nil {
+			return PortfolioResp{}, sdk.Wrap(err)
+		}
+
+		redelegation := PortfolioRedelegation{
+			SrcValidator: newPortfolioValidator(src),
+			DstValidator: newPortfolioValidator(dst),
+		}
+		for _, entry := range r.Entries {
+			tokens := sdk.NewCoin(bondDenom, tokensFromShares(dst, entry.RedelegationEntry.SharesDst))
+			redelegating = redelegating.Add(tokens)
+			redelegation.Entries = append(redelegation.Entries, PortfolioRedelegationEntry{
+				RedelegationEntryResp: entry.RedelegationEntry.Convert().(RedelegationEntryResp),
+				Tokens:                tokens,
+			})
+		}
+		res.Redelegations = append(res.Redelegations, redelegation)
+	}
+
+	// the rewards are truncated to the min unit, as they are when withdrawn
+	rewardTotal, _ := rewards.Total.TruncateDecimal()
+
+	for _, total := range []struct {
+		amount *PortfolioAmount
+		coins  sdk.Coins
+	}{
+		{&res.Totals.Delegated, delegated},
+		{&res.Totals.Unbonding, unbonding},
+		{&res.Totals.Redelegating, redelegating},
+		{&res.Totals.Rewards, rewardTotal},
+	} {
+		main, err := sc.ToMainCoin(total.coins...)
+		if err != nil {
+			return PortfolioResp{}, err
+		}
+		*total.amount = PortfolioAmount{Min: total.coins, Main: main}
+	}
+	return res, nil
+}
+
+// portfolio pages through the staking queries of a delegator and caches the validators
+type portfolio struct {
+	client     QueryClient
+	validators map[string]Validator
+}
+
+func (p portfolio) validator(validatorAddr string) (Validator, error) {
+	if validator, ok := p.validators[validatorAddr]; ok {
+		return validator, nil
+	}
+
+	res, err := p.client.Validator(context.Background(), &QueryValidatorRequest{ValidatorAddr: validatorAddr})
+	if err != nil {
+		return Validator{}, err
+	}
+	p.validators[validatorAddr] = res.Validator
+	return res.Validator, nil
+}
+
+func (p portfolio) delegations(delegatorAddr string) (delegations []DelegationResponse, err error) {
+	err = pageThrough(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := p.client.DelegatorDelegations(context.Background(), &QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delegatorAddr,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, res.DelegationResponses...)
+		return res.Pagination, nil
+	})
+	return delegations, err
+}
+
+func (p portfolio) unbondings(delegatorAddr string) (unbondings []UnbondingDelegation, err error) {
+	err = pageThrough(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := p.client.DelegatorUnbondingDelegations(context.Background(), &QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: delegatorAddr,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		unbondings = append(unbondings, res.UnbondingResponses...)
+		return res.Pagination, nil
+	})
+	return unbondings, err
+}
+
+func (p portfolio) redelegations(delegatorAddr string) (redelegations []RedelegationResponse, err error) {
+	err = pageThrough(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := p.client.Redelegations(context.Background(), &QueryRedelegationsRequest{
+			DelegatorAddr: delegatorAddr,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		redelegations = append(redelegations, res.RedelegationResponses...)
+		return res.Pagination, nil
+	})
+	return redelegations, err
+}
+
+// pageThrough queries the pages with their next keys until the last one
+func pageThrough(queryPage func(page *query.PageRequest) (*query.PageResponse, error)) error {
+	var key []byte
+	for {
+		res, err := queryPage(&query.PageRequest{Key: key, Limit: portfolioPageSize})
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		key = res.NextKey
+	}
+}
+
+// tokensFromShares converts the shares of the validator to tokens, truncated like the staking module does
+func tokensFromShares(validator Validator, shares sdk.Dec) sdk.Int {
+	if validator.DelegatorShares.IsNil() || validator.DelegatorShares.IsZero() {
+		return sdk.ZeroInt()
+	}
+	return shares.MulInt(validator.Tokens).QuoTruncate(validator.DelegatorShares).TruncateInt()
+}
+
+func newPortfolioValidator(validator Validator) PortfolioValidator {
+	exchangeRate := sdk.ZeroDec()
+	if !validator.DelegatorShares.IsNil() && !validator.DelegatorShares.IsZero() {
+		exchangeRate = sdk.NewDecFromInt(validator.Tokens).Quo(validator.DelegatorShares)
+	}
+	return PortfolioValidator{
+		OperatorAddress: validator.OperatorAddress,
+		Moniker:         validator.Description.Moniker,
+		Status:          BondStatus_name[int32(validator.Status)],
+		Jailed:          validator.Jailed,
+		CommissionRate:  validator.Commission.Rate,
+		ExchangeRate:    exchangeRate,
+	}
+}
@@ -357,9 +357,9 @@ func (q QueryValidatorUnbondingDelegationsResponse) Convert() interface{} {
 }
 
 func (u UnbondingDelegation) Convert() interface{} {
-	var entries []unbondingDelegationEntry
+	var entries []UnbondingDelegationEntryResp
 	for _, v := range u.Entries {
-		entries = append(entries, v.Convert().(unbondingDelegationEntry))
+		entries = append(entries, v.Convert().(UnbondingDelegationEntryResp))
 	}
 
 	return QueryUnbondingDelegationResp{
@@ -370,7 +370,7 @@ func (u UnbondingDelegation) Convert() interface{} {
 }
 
 func (u UnbondingDelegationEntry) Convert() interface{} {
-	return unbondingDelegationEntry{
+	return UnbondingDelegationEntryResp{
 		CreationHeight: u.CreationHeight,
 		CompletionTime: u.CompletionTime,
 		InitialBalance: u.InitialBalance,
@@ -386,7 +386,7 @@ func (q QueryDelegatorDelegationsResponse) Convert() interface{} {
 
 	return QueryDelegatorDelegationsResp{
 		DelegationResponses: delegationResps,
-		Total:               0,
+		Total:               q.Pagination.Total,
 	}
 }
 
@@ -419,9 +419,9 @@ func (r RedelegationResponse) Convert() interface{} {
 		outerEntries = append(outerEntries, v.Convert().(redelegationEntryResponse))
 	}
 
-	var innerEntries []redelegationEntry
+	var innerEntries []RedelegationEntryResp
 	for _, v := range r.Redelegation.Entries {
-		innerEntries = append(innerEntries, v.Convert().(redelegationEntry))
+		innerEntries = append(innerEntries, v.Convert().(RedelegationEntryResp))
 	}
 
 	return RedelegationResp{
@@ -436,7 +436,7 @@ func (r RedelegationResponse) Convert() interface{} {
 }
 
 func (r RedelegationEntry) Convert() interface{} {
-	return redelegationEntry{
+	return RedelegationEntryResp{
 		CreationHeight: r.CreationHeight,
 		CompletionTime: r.CompletionTime,
 		InitialBalance: r.InitialBalance,
@@ -446,7 +446,7 @@ func (r RedelegationEntry) Convert() interface{} {
 
 func (r RedelegationEntryResponse) Convert() interface{} {
 	return redelegationEntryResponse{
-		RedelegationEntry: redelegationEntry{
+		RedelegationEntry: RedelegationEntryResp{
 			CreationHeight: r.RedelegationEntry.CreationHeight,
 			CompletionTime: r.RedelegationEntry.CompletionTime,
 			InitialBalance: r.RedelegationEntry.InitialBalance,
@@ -19,11 +19,18 @@ type tokenQuery struct {
 	cdc codec.Marshaler
 	log.Logger
 	cache.Cache
+	metrics sdk.Metrics
 }
 
 func (l tokenQuery) QueryToken(denom string) (sdk.Token, error) {
+	return l.queryToken(context.Background(), denom)
+}
+
+func (l tokenQuery) queryToken(ctx context.Context, denom string) (sdk.Token, error) {
 	denom = strings.ToLower(denom)
-	if t, err := l.Get(l.prefixKey(denom)); err == nil {
+	t, err := l.Get(l.prefixKey(denom))
+	l.metrics.CacheAccess("token", err == nil)
+	if err == nil {
 		return t.(sdk.Token), nil
 	}
 
@@ -34,7 +41,7 @@ func (l tokenQuery) QueryToken(denom string) (sdk.Token, error) {
 	}
 
 	response, err := token.NewQueryClient(conn).Token(
-		context.Background(),
+		ctx,
 		&token.QueryTokenRequest{Denom: denom},
 	)
 	if err != nil {
@@ -61,8 +68,12 @@ func (l tokenQuery) SaveTokens(tokens ...sdk.Token) {
 }
 
 func (l tokenQuery) ToMinCoin(coins ...sdk.DecCoin) (dstCoins sdk.Coins, err sdk.Error) {
+	return l.toMinCoin(context.Background(), coins...)
+}
+
+func (l tokenQuery) toMinCoin(ctx context.Context, coins ...sdk.DecCoin) (dstCoins sdk.Coins, err sdk.Error) {
 	for _, coin := range coins {
-		token, err := l.QueryToken(coin.Denom)
+		token, err := l.queryToken(ctx, coin.Denom)
 		if err != nil {
 			return nil, sdk.Wrap(err)
 		}
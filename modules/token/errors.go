@@ -0,0 +1,24 @@
+package token
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// errors returned by the token module of irishub, use errors.Is to match them
+var (
+	ErrInvalidName          = sdk.Register(ModuleName, 2, "invalid token name")
+	ErrInvalidMinUnit       = sdk.Register(ModuleName, 3, "invalid token min unit")
+	ErrInvalidSymbol        = sdk.Register(ModuleName, 4, "invalid standard denom")
+	ErrInvalidInitSupply    = sdk.Register(ModuleName, 5, "invalid token initial supply")
+	ErrInvalidMaxSupply     = sdk.Register(ModuleName, 6, "invalid token maximum supply")
+	ErrInvalidScale         = sdk.Register(ModuleName, 7, "invalid token scale")
+	ErrSymbolAlreadyExists  = sdk.Register(ModuleName, 8, "symbol already exists")
+	ErrMinUnitAlreadyExists = sdk.Register(ModuleName, 9, "min unit already exists")
+	ErrTokenNotExists       = sdk.Register(ModuleName, 10, "token does not exist")
+	ErrInvalidAddress       = sdk.Register(ModuleName, 11, "the owner of the token must be specified")
+	ErrInvalidToAddress     = sdk.Register(ModuleName, 12, "the new owner must not be same as the original owner")
+	ErrInvalidOwner         = sdk.Register(ModuleName, 13, "invalid token owner")
+	ErrNotMintable          = sdk.Register(ModuleName, 14, "token is not mintable")
+	ErrNotFoundTokenAmt     = sdk.Register(ModuleName, 15, "burned token amount not found")
+	ErrInsufficientFee      = sdk.Register(ModuleName, 16, "insufficient fee")
+)
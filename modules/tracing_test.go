@@ -0,0 +1,50 @@
+package modules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	node := newTestNode(t)
+	base := newTestClient(t, node, sdk.TracerProviderOption(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))))
+
+	msgs, baseTx := testSend(t, base)
+	_, err := base.BuildAndSend(msgs, baseTx)
+	require.NoError(t, err)
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	parent := func(name string) string {
+		span, ok := spans[name]
+		require.True(t, ok, name)
+		for _, s := range spans {
+			if s.SpanContext().SpanID() == span.Parent().SpanID() {
+				return s.Name()
+			}
+		}
+		return ""
+	}
+
+	require.Equal(t, "", parent("BuildAndSend"))
+	require.Equal(t, "BuildAndSend", parent("prepare"))
+	// the queries of the account and of the fee token are made by prepare
+	require.Equal(t, "prepare", parent("cosmos.auth.v1beta1.Query/Account"))
+	require.Equal(t, "prepare", parent("cosmos.bank.v1beta1.Query/AllBalances"))
+	require.Equal(t, "prepare", parent("irismod.token.Query/Token"))
+	require.Equal(t, "BuildAndSend", parent("BuildAndSign"))
+	require.Equal(t, "BuildAndSend", parent("broadcastTx"))
+
+	traceID := spans["BuildAndSend"].SpanContext().TraceID()
+	for name, span := range spans {
+		require.Equal(t, traceID, span.SpanContext().TraceID(), name)
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"go.opentelemetry.io/otel/trace"
 
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	clienttx "github.com/irisnet/irishub-sdk-go/client/tx"
@@ -22,37 +26,92 @@ func (base baseClient) QueryTx(hash string) (sdk.ResultQueryTx, error) {
 		return sdk.ResultQueryTx{}, err
 	}
 
-	res, err := base.Tx(context.Background(), tx, true)
+	var res *ctypes.ResultTx
+	err = base.retryQuery(context.Background(), "query tx", func() (err error) {
+		res, err = base.Tx(context.Background(), tx, true)
+		return err
+	})
 	if err != nil {
 		return sdk.ResultQueryTx{}, err
 	}
 
-	resBlocks, err := base.getResultBlocks([]*ctypes.ResultTx{res})
+	blockTimes, err := base.blockTimes([]*ctypes.ResultTx{res})
 	if err != nil {
 		return sdk.ResultQueryTx{}, err
 	}
-	return base.parseTxResult(res, resBlocks[res.Height])
+	return base.parseTxResult(res, blockTimes[res.Height])
 }
 
+// QueryTxs returns the txs matching the query in ascending order
 func (base baseClient) QueryTxs(builder *sdk.EventQueryBuilder, page, size int) (sdk.ResultSearchTxs, error) {
+	return base.SearchTxs(builder, page, size, sdk.OrderAsc)
+}
+
+// SearchTxs returns the page-th (starting from 1) page of the txs matching the query in the given order
+func (base baseClient) SearchTxs(builder *sdk.EventQueryBuilder, page, size int, order sdk.Order) (sdk.ResultSearchTxs, error) {
 	query := builder.Build()
 	if len(query) == 0 {
 		return sdk.ResultSearchTxs{}, errors.New("must declare at least one tag to search")
 	}
+	return base.searchTxs(query, page, size, order)
+}
+
+// IterateTxs returns an iterator over all the txs matching the query in the given order, fetching size txs per request.
+// The txs committed after the first request are not returned in descending order, so that the pages do not shift.
+func (base baseClient) IterateTxs(builder *sdk.EventQueryBuilder, size int, order sdk.Order) *sdk.TxIterator {
+	query := builder.Build()
+	if len(query) == 0 {
+		return sdk.NewTxIterator(func(int) (sdk.ResultSearchTxs, error) {
+			return sdk.ResultSearchTxs{}, errors.New("must declare at least one tag to search")
+		})
+	}
+
+	var once sync.Once
+	var pinErr error
+	return sdk.NewTxIterator(func(page int) (sdk.ResultSearchTxs, error) {
+		if order == sdk.OrderDesc {
+			once.Do(func() {
+				status, err := base.Status(context.Background())
+				if err != nil {
+					pinErr = err
+					return
+				}
+				latest := sdk.NewEventQueryBuilder().ToHeight(status.SyncInfo.LatestBlockHeight)
+				query = fmt.Sprintf("%s AND %s", query, latest.Build())
+			})
+			if pinErr != nil {
+				return sdk.ResultSearchTxs{}, pinErr
+			}
+		}
+		return base.searchTxs(query, page, size, order)
+	})
+}
+
+func (base baseClient) searchTxs(query string, page, size int, order sdk.Order) (sdk.ResultSearchTxs, error) {
+	if len(order) == 0 {
+		order = sdk.OrderAsc
+	}
+	if err := order.Validate(); err != nil {
+		return sdk.ResultSearchTxs{}, err
+	}
 
-	res, err := base.TxSearch(context.Background(), query, true, &page, &size, "asc")
+	var res *ctypes.ResultTxSearch
+	err := base.retryQuery(context.Background(), "search txs", func() (err error) {
+		res, err = base.TxSearch(context.Background(), query, true, &page, &size, string(order))
+		return err
+	})
 	if err != nil {
 		return sdk.ResultSearchTxs{}, err
 	}
 
-	resBlocks, err := base.getResultBlocks(res.Txs)
+	blockTimes, err := base.blockTimes(res.Txs)
 	if err != nil {
 		return sdk.ResultSearchTxs{}, err
 	}
 
 	var txs []sdk.ResultQueryTx
-	for i, tx := range res.Txs {
-		txInfo, err := base.parseTxResult(tx, resBlocks[res.Txs[i].Height])
+	for _, tx := range res.Txs {
+		txInfo, err := base.parseTxResult(tx, blockTimes[tx.Height])
 		if err != nil {
 			return sdk.ResultSearchTxs{}, err
 		}
@@ -65,26 +124,15 @@ func (base baseClient) QueryTxs(builder *sdk.EventQueryBuilder, page, size int)
 	}, nil
 }
 
-func (base baseClient) QueryBlock(height int64) (sdk.BlockDetail, error) {
-	block, err := base.Block(context.Background(), &height)
-	if err != nil {
-		return sdk.BlockDetail{}, err
-	}
-
-	blockResult, err := base.BlockResults(context.Background(), &height)
-	if err != nil {
-		return sdk.BlockDetail{}, err
-	}
-
-	return sdk.BlockDetail{
-		BlockID:     block.BlockID,
-		Block:       sdk.ParseBlock(base.encodingConfig.Amino, block.Block),
-		BlockResult: sdk.ParseBlockResult(blockResult),
-	}, nil
+func (base baseClient) EstimateTxGas(txBytes []byte) (uint64, error) {
+	return base.estimateTxGas(context.Background(), txBytes)
 }
 
-func (base baseClient) EstimateTxGas(txBytes []byte) (uint64, error) {
-	res, err := base.ABCIQuery(context.Background(), "/app/simulate", txBytes)
+func (base baseClient) estimateTxGas(ctx context.Context, txBytes []byte) (_ uint64, err error) {
+	ctx, span := base.tracer.Start(ctx, "EstimateTxGas")
+	defer func() { endSpan(span, err) }()
+
+	res, err := base.abciQuery(ctx, "/app/simulate", txBytes, rpcclient.DefaultABCIQueryOptions)
 	if err != nil {
 		return 0, err
 	}
@@ -98,14 +146,15 @@ func (base baseClient) EstimateTxGas(txBytes []byte) (uint64, error) {
 	return adjusted, nil
 }
 
-func (base *baseClient) buildTx(msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *clienttx.Factory, sdk.Error) {
-	builder, err := base.prepare(baseTx)
+func (base *baseClient) buildTx(ctx context.Context, msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *clienttx.Factory, sdk.Error) {
+	builder, err := base.prepare(ctx, baseTx)
 	if err != nil {
 		return nil, builder, sdk.Wrap(err)
 	}
 
-	txByte, err := builder.BuildAndSign(baseTx.From, msgs)
+	txByte, err := base.buildAndSign(ctx, builder, baseTx.From, msgs)
 	if err != nil {
+		base.sequences.release(builder.Address(), builder.Sequence())
 		return nil, builder, sdk.Wrap(err)
 	}
 
@@ -113,13 +162,13 @@ func (base *baseClient) buildTx(msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *cli
 	return txByte, builder, nil
 }
 
-func (base *baseClient) buildTxWithAccount(addr string, accountNumber, sequence uint64, msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *clienttx.Factory, sdk.Error) {
-	builder, err := base.prepareTemp(addr, accountNumber, sequence, baseTx)
+func (base *baseClient) buildTxWithAccount(ctx context.Context, addr string, accountNumber, sequence uint64, msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *clienttx.Factory, sdk.Error) {
+	builder, err := base.prepareTemp(ctx, addr, accountNumber, sequence, baseTx)
 	if err != nil {
 		return nil, builder, sdk.Wrap(err)
 	}
 
-	txByte, err := builder.BuildAndSign(baseTx.From, msgs)
+	txByte, err := base.buildAndSign(ctx, builder, baseTx.From, msgs)
 	if err != nil {
 		return nil, builder, sdk.Wrap(err)
 	}
@@ -128,9 +177,18 @@ func (base *baseClient) buildTxWithAccount(addr string, accountNumber, sequence
 	return txByte, builder, nil
 }
 
-func (base baseClient) broadcastTx(txBytes []byte, mode sdk.BroadcastMode, simulate bool) (res sdk.ResultTx, err sdk.Error) {
+func (base *baseClient) buildAndSign(ctx context.Context, builder *clienttx.Factory, name string, msgs []sdk.Msg) (_ []byte, err error) {
+	_, span := base.tracer.Start(ctx, "BuildAndSign", trace.WithAttributes(
+		attrAddress.String(builder.Address()),
+	))
+	defer func() { endSpan(span, err) }()
+
+	return builder.BuildAndSign(name, msgs)
+}
+
+func (base baseClient) broadcastTx(ctx context.Context, txBytes []byte, mode sdk.BroadcastMode, simulate bool) (res sdk.ResultTx, err sdk.Error) {
 	if simulate {
-		estimateGas, err := base.EstimateTxGas(txBytes)
+		estimateGas, err := base.estimateTxGas(ctx, txBytes)
 		if err != nil {
 			return res, sdk.Wrap(err)
 		}
@@ -138,54 +196,72 @@ func (base baseClient) broadcastTx(txBytes []byte, mode sdk.BroadcastMode, simul
 		return res, nil
 	}
 
+	ctx, span := base.tracer.Start(ctx, "broadcastTx", trace.WithAttributes(
+		attrTxMode.String(string(mode)),
+	))
+	defer func() {
+		span.SetAttributes(attrTxHash.String(res.Hash), attrTxHeight.Int64(res.Height))
+		endSpan(span, err)
+	}()
+
 	switch mode {
 	case sdk.Commit:
-		res, err = base.broadcastTxCommit(txBytes)
+		res, err = base.broadcastTxCommit(ctx, txBytes)
 	case sdk.Async:
-		res, err = base.broadcastTxAsync(txBytes)
+		res, err = base.broadcastTxAsync(ctx, txBytes)
 	case sdk.Sync:
-		res, err = base.broadcastTxSync(txBytes)
+		res, err = base.broadcastTxSync(ctx, txBytes)
 	default:
 		err = sdk.Wrapf("commit mode(%s) not supported", mode)
 	}
+
+	var code uint32
+	if err != nil {
+		code = err.Code()
+	}
+	base.cfg.Metrics.TxBroadcast(mode, code)
+	span.SetAttributes(attrTxCode.Int64(int64(code)))
 	return
 }
 
 // broadcastTxCommit broadcasts transaction bytes to a Tendermint node
 // and waits for a commit.
-func (base baseClient) broadcastTxCommit(tx []byte) (sdk.ResultTx, sdk.Error) {
-	res, err := base.BroadcastTxCommit(context.Background(), tx)
+func (base baseClient) broadcastTxCommit(ctx context.Context, tx []byte) (sdk.ResultTx, sdk.Error) {
+	res, err := base.BroadcastTxCommit(ctx, tx)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
 	if !res.CheckTx.IsOK() {
-		return sdk.ResultTx{}, sdk.GetError(res.CheckTx.Codespace, res.CheckTx.Code, res.CheckTx.Log)
-	}
-
-	if !res.DeliverTx.IsOK() {
-		return sdk.ResultTx{}, sdk.GetError(res.DeliverTx.Codespace, res.DeliverTx.Code, res.DeliverTx.Log)
+		return sdk.ResultTx{}, sdk.GetTxError(res.Hash.String(), res.CheckTx.Codespace, res.CheckTx.Code, res.CheckTx.Log)
 	}
 
-	return sdk.ResultTx{
+	result := sdk.ResultTx{
 		GasWanted: res.DeliverTx.GasWanted,
 		GasUsed:   res.DeliverTx.GasUsed,
 		Events:    sdk.StringifyEvents(res.DeliverTx.Events),
 		Hash:      res.Hash.String(),
 		Height:    res.Height,
-	}, nil
+	}
+
+	// the transaction is included in the block even if it failed, return the result with the error
+	if !res.DeliverTx.IsOK() {
+		return result, sdk.GetTxError(res.Hash.String(), res.DeliverTx.Codespace, res.DeliverTx.Code, res.DeliverTx.Log)
+	}
+
+	return result, nil
 }
 
 // BroadcastTxSync broadcasts transaction bytes to a Tendermint node
 // synchronously.
-func (base baseClient) broadcastTxSync(tx []byte) (sdk.ResultTx, sdk.Error) {
-	res, err := base.BroadcastTxSync(context.Background(), tx)
+func (base baseClient) broadcastTxSync(ctx context.Context, tx []byte) (sdk.ResultTx, sdk.Error) {
+	res, err := base.BroadcastTxSync(ctx, tx)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
 	if res.Code != 0 {
-		return sdk.ResultTx{}, sdk.GetError(sdk.RootCodespace, res.Code, res.Log)
+		return sdk.ResultTx{}, sdk.GetTxError(res.Hash.String(), res.Codespace, res.Code, res.Log)
 	}
 
 	return sdk.ResultTx{Hash: res.Hash.String()}, nil
@@ -193,8 +269,8 @@ func (base baseClient) broadcastTxSync(tx []byte) (sdk.ResultTx, sdk.Error) {
 
 // BroadcastTxAsync broadcasts transaction bytes to a Tendermint node
 // asynchronously.
-func (base baseClient) broadcastTxAsync(tx []byte) (sdk.ResultTx, sdk.Error) {
-	res, err := base.BroadcastTxAsync(context.Background(), tx)
+func (base baseClient) broadcastTxAsync(ctx context.Context, tx []byte) (sdk.ResultTx, sdk.Error) {
+	res, err := base.BroadcastTxAsync(ctx, tx)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
@@ -202,32 +278,73 @@ func (base baseClient) broadcastTxAsync(tx []byte) (sdk.ResultTx, sdk.Error) {
 	return sdk.ResultTx{Hash: res.Hash.String()}, nil
 }
 
-func (base baseClient) getResultBlocks(resTxs []*ctypes.ResultTx) (map[int64]*ctypes.ResultBlock, error) {
-	resBlocks := make(map[int64]*ctypes.ResultBlock)
+// blockBatcher fetches several blocks in one request
+type blockBatcher interface {
+	blocks(ctx context.Context, heights []int64) ([]*ctypes.ResultBlock, error)
+}
+
+// blockTimes returns the time of the blocks containing the txs, the blocks not cached are fetched in one batch request
+func (base baseClient) blockTimes(resTxs []*ctypes.ResultTx) (map[int64]time.Time, error) {
+	blockTimes := make(map[int64]time.Time)
+	var heights []int64
 	for _, resTx := range resTxs {
-		if _, ok := resBlocks[resTx.Height]; !ok {
-			resBlock, err := base.Block(context.Background(), &resTx.Height)
-			if err != nil {
-				return nil, err
-			}
+		if _, ok := blockTimes[resTx.Height]; ok {
+			continue
+		}
+
+		v, err := base.blockTimeCache.Get(resTx.Height)
+		base.cfg.Metrics.CacheAccess("block_time", err == nil)
+		if err != nil {
+			heights = append(heights, resTx.Height)
+			blockTimes[resTx.Height] = time.Time{}
+			continue
+		}
+		blockTimes[resTx.Height] = v.(time.Time)
+	}
 
-			resBlocks[resTx.Height] = resBlock
+	if len(heights) == 0 {
+		return blockTimes, nil
+	}
+
+	var blocks []*ctypes.ResultBlock
+	err := base.retryQuery(context.Background(), "query block times", func() (err error) {
+		blocks = nil
+		if c, ok := base.TmClient.(blockBatcher); ok {
+			blocks, err = c.blocks(context.Background(), heights)
+			return err
+		}
+		for i := range heights {
+			var block *ctypes.ResultBlock
+			if block, err = base.Block(context.Background(), &heights[i]); err != nil {
+				return err
+			}
+			blocks = append(blocks, block)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range blocks {
+		blockTimes[block.Block.Height] = block.Block.Time
+		_ = base.blockTimeCache.Set(block.Block.Height, block.Block.Time)
 	}
-	return resBlocks, nil
+	return blockTimes, nil
 }
 
-func (base baseClient) parseTxResult(res *ctypes.ResultTx, resBlock *ctypes.ResultBlock) (sdk.ResultQueryTx, error) {
+func (base baseClient) parseTxResult(res *ctypes.ResultTx, blockTime time.Time) (sdk.ResultQueryTx, error) {
 	var tx sdk.Tx
 	var err error
 
-	if tx, err = base.encodingConfig.TxConfig.TxDecoder()(res.Tx); err != nil {
+	if tx, err = base.txDecoder(res.Tx); err != nil {
 		return sdk.ResultQueryTx{}, err
 	}
 
 	return sdk.ResultQueryTx{
 		Hash:   res.Hash.String(),
 		Height: res.Height,
+		Index:  res.Index,
 		Tx:     tx,
 		Result: sdk.TxResult{
 			Code:      res.TxResult.Code,
@@ -236,7 +353,7 @@ func (base baseClient) parseTxResult(res *ctypes.ResultTx, resBlock *ctypes.Resu
 			GasUsed:   res.TxResult.GasUsed,
 			Events:    sdk.StringifyEvents(res.TxResult.Events),
 		},
-		Timestamp: resBlock.Block.Time.Format(time.RFC3339),
+		Timestamp: blockTime.Format(time.RFC3339),
 	}, nil
 }
 
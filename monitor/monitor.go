@@ -0,0 +1,313 @@
+// Package monitor watches a set of validators block by block and reports their incidents as typed alerts:
+// missed signatures, voting power and bonding status changes, jailing, tombstoning and the edits of their
+// commission or description.
+//
+//	m, err := monitor.New(&client, monitor.Options{
+//		Validators:      []string{"iva1..."},
+//		MissedThreshold: 3,
+//		OnAlert: func(alert monitor.Alert) {
+//			log.Println(alert.Type, alert.Validator.Moniker, alert.Height)
+//		},
+//	})
+//	err = m.Run(ctx)
+//
+// The signatures are read from the last commit of every block. The state of the validators is queried from
+// the staking and slashing modules every RefreshInterval blocks and compared with the previous one, so the
+// changes are reported at the height they are observed, the first state is only recorded.
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	defaultMissedThreshold = 1
+	defaultRefreshInterval = 1
+)
+
+// AlertType is the kind of an alert
+type AlertType string
+
+const (
+	// AlertMissedSignature is raised for every block missed once MissedThreshold blocks are missed in a row
+	AlertMissedSignature AlertType = "missed_signature"
+	// AlertSignatureRecovered is raised for the first block signed after an alerted missed signature
+	AlertSignatureRecovered AlertType = "signature_recovered"
+	AlertVotingPowerChange  AlertType = "voting_power_change"
+	AlertStatusChange       AlertType = "status_change"
+	AlertJailed             AlertType = "jailed"
+	AlertUnjailed           AlertType = "unjailed"
+	AlertTombstoned         AlertType = "tombstoned"
+	AlertCommissionChange   AlertType = "commission_change"
+	AlertDescriptionChange  AlertType = "description_change"
+)
+
+// Client is the client the validators are monitored with, e.g. *sdk.IRISHUBClient
+type Client interface {
+	sdk.StatusClient
+	StreamBlocks(ctx context.Context, from, to int64, opts sdk.StreamBlocksOptions, handler sdk.BlockHandler) error
+	GenConn() (*grpc.ClientConn, error)
+	AppCodec() codec.Marshaler
+}
+
+// Options are the options of a Monitor
+type Options struct {
+	// operator addresses of the monitored validators
+	Validators []string
+	// height the monitoring starts from, the latest block by default
+	StartHeight int64
+	// number of blocks missed in a row before the missed signatures are alerted, 1 by default
+	MissedThreshold int64
+	// number of blocks between two queries of the state of the validators, 1 by default
+	RefreshInterval int64
+	// minimum relative change of the voting power alerted, e.g. 0.1 for 10%, every change by default
+	PowerChangeThreshold sdk.Dec
+	// options of streaming the blocks
+	Stream sdk.StreamBlocksOptions
+	// called with every alert, from the goroutine of Run
+	OnAlert func(Alert)
+	Logger  log.Logger
+}
+
+// ValidatorState is the state of a monitored validator
+type ValidatorState struct {
+	OperatorAddress  string              `json:"operator_address"`
+	ConsensusAddress string              `json:"consensus_address"`
+	Moniker          string              `json:"moniker"`
+	Description      staking.Description `json:"description"`
+	Status           string              `json:"status"`
+	Jailed           bool                `json:"jailed"`
+	JailedUntil      time.Time           `json:"jailed_until"`
+	Tombstoned       bool                `json:"tombstoned"`
+	// tokens of the validator when bonded, zero otherwise
+	VotingPower    sdk.Int `json:"voting_power"`
+	CommissionRate sdk.Dec `json:"commission_rate"`
+	// missed blocks of the signing window, as counted by the slashing module
+	MissedBlocksCounter int64 `json:"missed_blocks_counter"`
+	// number of blocks missed in a row, and height of the last block signed
+	Missed     int64 `json:"missed"`
+	LastSigned int64 `json:"last_signed"`
+
+	consAddress sdk.ConsAddress
+}
+
+// Alert is an incident of a validator
+type Alert struct {
+	Type   AlertType `json:"type"`
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+	// state of the validator, after the change for the changes of its state
+	Validator ValidatorState `json:"validator"`
+	// state of the validator before the change, nil for the signatures
+	Previous *ValidatorState `json:"previous,omitempty"`
+}
+
+// Monitor watches the validators of the options
+type Monitor struct {
+	client  Client
+	querier querier
+	opts    Options
+
+	mu          sync.RWMutex
+	validators  map[string]ValidatorState
+	lastRefresh int64
+}
+
+// New returns a Monitor of the validators of opts
+func New(client Client, opts Options) (*Monitor, error) {
+	if len(opts.Validators) == 0 {
+		return nil, errors.New("no validator to monitor")
+	}
+	for _, validator := range opts.Validators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return nil, err
+		}
+	}
+	if opts.OnAlert == nil {
+		return nil, errors.New("OnAlert is required")
+	}
+	if opts.MissedThreshold <= 0 {
+		opts.MissedThreshold = defaultMissedThreshold
+	}
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = defaultRefreshInterval
+	}
+	if opts.PowerChangeThreshold.IsNil() {
+		opts.PowerChangeThreshold = sdk.ZeroDec()
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+
+	return &Monitor{
+		client:     client,
+		querier:    grpcQuerier{client: client},
+		opts:       opts,
+		validators: make(map[string]ValidatorState, len(opts.Validators)),
+	}, nil
+}
+
+// Run monitors the blocks from StartHeight on until ctx is done. A block which can not be checked,
+// e.g. because of a failed query, is logged and the monitoring goes on
+func (m *Monitor) Run(ctx context.Context) error {
+	from := m.opts.StartHeight
+	if from <= 0 {
+		status, err := m.client.Status(ctx)
+		if err != nil {
+			return err
+		}
+		from = status.SyncInfo.LatestBlockHeight
+	}
+
+	return m.client.StreamBlocks(ctx, from, 0, m.opts.Stream, func(block sdk.BlockDetail) error {
+		if err := m.HandleBlock(block); err != nil {
+			m.opts.Logger.Error("monitor block failed", "height", block.Block.Height, "errMsg", err.Error())
+		}
+		return nil
+	})
+}
+
+// Validators returns the last known state of the monitored validators
+func (m *Monitor) Validators() []ValidatorState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	states := make([]ValidatorState, 0, len(m.validators))
+	for _, operator := range m.opts.Validators {
+		if state, ok := m.validators[operator]; ok {
+			states = append(states, state)
+		}
+	}
+	return states
+}
+
+// HandleBlock refreshes the state of the validators when due and checks the signatures of the last commit
+// of block, the blocks must be handled in order
+func (m *Monitor) HandleBlock(block sdk.BlockDetail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var err error
+	height := block.Block.Height
+	if len(m.validators) == 0 || height-m.lastRefresh >= m.opts.RefreshInterval {
+		err = m.refresh(height, block.Block.Time)
+	}
+	m.checkSignatures(block.Block.LastCommit, block.Block.Time)
+	return err
+}
+
+// refresh queries the state of the validators and alerts its changes, the validators which can not be
+// queried keep their previous state
+func (m *Monitor) refresh(height int64, blockTime time.Time) error {
+	m.lastRefresh = height
+
+	var failed error
+	for _, operator := range m.opts.Validators {
+		state, err := m.querier.validator(operator)
+		if err != nil {
+			failed = sdk.Wrapf("query validator %s failed: %s", operator, err.Error())
+			continue
+		}
+
+		previous, ok := m.validators[operator]
+		state.Missed, state.LastSigned = previous.Missed, previous.LastSigned
+		m.validators[operator] = state
+		if ok {
+			m.compare(height, blockTime, previous, state)
+		}
+	}
+	return failed
+}
+
+func (m *Monitor) compare(height int64, blockTime time.Time, previous, state ValidatorState) {
+	alert := func(typ AlertType) {
+		m.opts.OnAlert(Alert{Type: typ, Height: height, Time: blockTime, Validator: state, Previous: &previous})
+	}
+
+	if previous.Status != state.Status {
+		alert(AlertStatusChange)
+	}
+	if !previous.Jailed && state.Jailed {
+		alert(AlertJailed)
+	}
+	if previous.Jailed && !state.Jailed {
+		alert(AlertUnjailed)
+	}
+	if !previous.Tombstoned && state.Tombstoned {
+		alert(AlertTombstoned)
+	}
+	if m.powerChanged(previous.VotingPower, state.VotingPower) {
+		alert(AlertVotingPowerChange)
+	}
+	if !previous.CommissionRate.Equal(state.CommissionRate) {
+		alert(AlertCommissionChange)
+	}
+	if !previous.Description.Equal(&state.Description) {
+		alert(AlertDescriptionChange)
+	}
+}
+
+func (m *Monitor) powerChanged(previous, power sdk.Int) bool {
+	if previous.Equal(power) {
+		return false
+	}
+	if previous.IsZero() {
+		return true
+	}
+	change := power.Sub(previous)
+	if change.IsNegative() {
+		change = change.Neg()
+	}
+	return sdk.NewDecFromInt(change).QuoInt(previous).GTE(m.opts.PowerChangeThreshold)
+}
+
+// checkSignatures counts the blocks missed by the bonded validators in the commit
+func (m *Monitor) checkSignatures(commit *tmtypes.Commit, blockTime time.Time) {
+	if commit == nil || commit.Height == 0 {
+		return
+	}
+
+	bonded := staking.BondStatus_name[int32(staking.Bonded)]
+	for _, operator := range m.opts.Validators {
+		state, ok := m.validators[operator]
+		if !ok || state.Status != bonded || len(state.consAddress) == 0 {
+			continue
+		}
+
+		if signed(commit, state.consAddress) {
+			if state.Missed >= m.opts.MissedThreshold {
+				m.opts.OnAlert(Alert{Type: AlertSignatureRecovered, Height: commit.Height, Time: blockTime, Validator: state})
+			}
+			state.Missed = 0
+			state.LastSigned = commit.Height
+		} else {
+			state.Missed++
+			if state.Missed >= m.opts.MissedThreshold {
+				m.opts.OnAlert(Alert{Type: AlertMissedSignature, Height: commit.Height, Time: blockTime, Validator: state})
+			}
+		}
+		m.validators[operator] = state
+	}
+}
+
+// signed tells if the validator signed the commit, a vote for nil is a signature like for the slashing module
+func signed(commit *tmtypes.Commit, consAddress sdk.ConsAddress) bool {
+	for _, sig := range commit.Signatures {
+		if sig.BlockIDFlag != tmtypes.BlockIDFlagAbsent && bytes.Equal(consAddress, sig.ValidatorAddress) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package monitor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// chain is the state of the validators, as queried from the staking and slashing modules
+type chain struct {
+	validators map[string]staking.Validator
+	infos      map[string]slashing.ValidatorSigningInfo
+	failing    bool
+}
+
+func (c *chain) validator(operatorAddr string) (ValidatorState, error) {
+	if c.failing {
+		return ValidatorState{}, errors.New("connection refused")
+	}
+	return newValidatorState(c.validators[operatorAddr], consAddress(operatorAddr), c.infos[operatorAddr]), nil
+}
+
+func consAddress(operatorAddr string) sdk.ConsAddress {
+	return sdk.ConsAddress(operatorAddr[len(operatorAddr)-20:])
+}
+
+// block returns a block whose last commit is signed by the validators
+func block(height int64, signers ...string) sdk.BlockDetail {
+	commit := &tmtypes.Commit{Height: height - 1}
+	for _, signer := range signers {
+		commit.Signatures = append(commit.Signatures, tmtypes.CommitSig{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: consAddress(signer).Bytes(),
+		})
+	}
+	// an absent signature has no address
+	commit.Signatures = append(commit.Signatures, tmtypes.NewCommitSigAbsent())
+
+	return sdk.BlockDetail{
+		Block: sdk.Block{
+			Header:     tmtypes.Header{Height: height, Time: time.Unix(height, 0)},
+			LastCommit: commit,
+		},
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	validator1 := sdk.ValAddress("validator1__________").String()
+	validator2 := sdk.ValAddress("validator2__________").String()
+	newValidator := func(operator, moniker string, tokens int64) staking.Validator {
+		return staking.Validator{
+			OperatorAddress: operator,
+			Status:          staking.Bonded,
+			Tokens:          sdk.NewInt(tokens),
+			Description:     staking.Description{Moniker: moniker},
+			Commission:      staking.Commission{CommissionRates: staking.CommissionRates{Rate: sdk.NewDecWithPrec(1, 1)}},
+		}
+	}
+
+	c := &chain{
+		validators: map[string]staking.Validator{
+			validator1: newValidator(validator1, "v1", 1000),
+			validator2: newValidator(validator2, "v2", 1000),
+		},
+		infos: map[string]slashing.ValidatorSigningInfo{},
+	}
+
+	var alerts []Alert
+	m, err := New(nil, Options{
+		Validators:           []string{validator1, validator2},
+		MissedThreshold:      2,
+		PowerChangeThreshold: sdk.NewDecWithPrec(1, 1),
+		OnAlert:              func(alert Alert) { alerts = append(alerts, alert) },
+	})
+	require.NoError(t, err)
+	m.querier = c
+
+	types := func() (res []AlertType) {
+		for _, alert := range alerts {
+			res = append(res, alert.Type)
+		}
+		alerts = nil
+		return res
+	}
+
+	// validator2 misses 2 blocks in a row then signs again
+	require.NoError(t, m.HandleBlock(block(2, validator1, validator2)))
+	require.NoError(t, m.HandleBlock(block(3, validator1)))
+	require.Empty(t, types())
+	require.NoError(t, m.HandleBlock(block(4, validator1)))
+	require.Equal(t, int64(3), alerts[0].Height)
+	require.Equal(t, validator2, alerts[0].Validator.OperatorAddress)
+	require.Equal(t, int64(2), alerts[0].Validator.Missed)
+	require.Equal(t, int64(1), alerts[0].Validator.LastSigned)
+	require.Equal(t, []AlertType{AlertMissedSignature}, types())
+	require.NoError(t, m.HandleBlock(block(5, validator1, validator2)))
+	require.Equal(t, []AlertType{AlertSignatureRecovered}, types())
+
+	// a change of 5% of the voting power is below the threshold
+	v := c.validators[validator1]
+	v.Tokens = sdk.NewInt(1050)
+	c.validators[validator1] = v
+	require.NoError(t, m.HandleBlock(block(6, validator1, validator2)))
+	require.Empty(t, types())
+
+	v.Tokens = sdk.NewInt(1200)
+	v.Commission.Rate = sdk.NewDecWithPrec(2, 1)
+	v.Description.Moniker = "v1-renamed"
+	c.validators[validator1] = v
+	require.NoError(t, m.HandleBlock(block(7, validator1, validator2)))
+	require.Equal(t, "v1", alerts[0].Previous.Moniker)
+	require.Equal(t, "v1-renamed", alerts[0].Validator.Moniker)
+	require.Equal(t, []AlertType{AlertVotingPowerChange, AlertCommissionChange, AlertDescriptionChange}, types())
+
+	// validator2 is jailed, unbonded and tombstoned, its signatures are not checked anymore
+	v = c.validators[validator2]
+	v.Jailed = true
+	v.Status = staking.Unbonding
+	c.validators[validator2] = v
+	c.infos[validator2] = slashing.ValidatorSigningInfo{Tombstoned: true, MissedBlocksCounter: 7}
+	require.NoError(t, m.HandleBlock(block(8, validator1)))
+	require.Equal(t, []AlertType{AlertStatusChange, AlertJailed, AlertTombstoned, AlertVotingPowerChange}, types())
+	require.NoError(t, m.HandleBlock(block(9, validator1)))
+	require.Empty(t, types())
+
+	states := m.Validators()
+	require.Len(t, states, 2)
+	require.True(t, states[1].Tombstoned)
+	require.Equal(t, int64(7), states[1].MissedBlocksCounter)
+	require.True(t, states[1].VotingPower.IsZero())
+
+	// a failed query keeps the previous state, the signatures are still checked
+	c.failing = true
+	require.Error(t, m.HandleBlock(block(10)))
+	require.Empty(t, types())
+	require.Error(t, m.HandleBlock(block(11)))
+	require.Equal(t, []AlertType{AlertMissedSignature}, types())
+}
@@ -0,0 +1,88 @@
+package monitor
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// querier queries the state of a validator
+type querier interface {
+	validator(operatorAddr string) (ValidatorState, error)
+}
+
+// grpcQuerier queries the staking and slashing modules
+type grpcQuerier struct {
+	client Client
+}
+
+func (q grpcQuerier) validator(operatorAddr string) (ValidatorState, error) {
+	conn, err := q.client.GenConn()
+	if err != nil {
+		return ValidatorState{}, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	res, err := staking.NewQueryClient(conn).Validator(
+		context.Background(),
+		&staking.QueryValidatorRequest{ValidatorAddr: operatorAddr},
+	)
+	if err != nil {
+		return ValidatorState{}, err
+	}
+
+	pubKey, err := res.Validator.GetPubKey(q.client.AppCodec())
+	if err != nil {
+		return ValidatorState{}, err
+	}
+	var consAddress sdk.ConsAddress
+	if pubKey != nil {
+		consAddress = sdk.ConsAddress(pubKey.Address())
+	}
+
+	// a validator never bonded has no signing info yet
+	var info slashing.ValidatorSigningInfo
+	if len(consAddress) > 0 {
+		signingInfo, err := slashing.NewQueryClient(conn).SigningInfo(
+			context.Background(),
+			&slashing.QuerySigningInfoRequest{ConsAddress: consAddress.String()},
+		)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return ValidatorState{}, err
+		}
+		if err == nil {
+			info = signingInfo.ValSigningInfo
+		}
+	}
+	return newValidatorState(res.Validator, consAddress, info), nil
+}
+
+func newValidatorState(validator staking.Validator, consAddress sdk.ConsAddress, info slashing.ValidatorSigningInfo) ValidatorState {
+	votingPower := sdk.ZeroInt()
+	if validator.Status == staking.Bonded {
+		votingPower = validator.Tokens
+	}
+
+	state := ValidatorState{
+		OperatorAddress:     validator.OperatorAddress,
+		Moniker:             validator.Description.Moniker,
+		Description:         validator.Description,
+		Status:              staking.BondStatus_name[int32(validator.Status)],
+		Jailed:              validator.Jailed,
+		JailedUntil:         info.JailedUntil,
+		Tombstoned:          info.Tombstoned,
+		VotingPower:         votingPower,
+		CommissionRate:      validator.Commission.Rate,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+		consAddress:         consAddress,
+	}
+	if len(consAddress) > 0 {
+		state.ConsensusAddress = consAddress.String()
+	}
+	return state
+}
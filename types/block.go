@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/base64"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/encoding"
@@ -11,6 +12,11 @@ import (
 	"github.com/irisnet/irishub-sdk-go/codec"
 )
 
+const (
+	defaultStreamConcurrency  = 4
+	defaultStreamPollInterval = 5 * time.Second
+)
+
 type Block struct {
 	tmtypes.Header `json:"header"`
 	Data           `json:"data"`
@@ -20,11 +26,17 @@ type Block struct {
 
 type Data struct {
 	Txs []StdTx `json:"txs"`
+	// txs decoded by ParseBlockWithDecoder, aligned with the DeliverTx results of the block
+	DecodedTxs []Tx `json:"decoded_txs,omitempty"`
+	// hashes of the txs, aligned with the DeliverTx results of the block
+	Hashes []string `json:"hashes"`
 }
 
 func ParseBlock(cdc *codec.LegacyAmino, block *tmtypes.Block) Block {
 	var txs []StdTx
-	for _, tx := range block.Txs {
+	hashes := make([]string, len(block.Txs))
+	for i, tx := range block.Txs {
+		hashes[i] = HexBytes(tx.Hash()).String()
 		var stdTx StdTx
 		if err := cdc.UnmarshalBinaryBare(tx, &stdTx); err == nil {
 			txs = append(txs, stdTx)
@@ -32,12 +44,26 @@ func ParseBlock(cdc *codec.LegacyAmino, block *tmtypes.Block) Block {
 	}
 	return Block{
 		Header:     block.Header,
-		Data:       Data{Txs: txs},
+		Data:       Data{Txs: txs, Hashes: hashes},
 		Evidence:   block.Evidence,
 		LastCommit: block.LastCommit,
 	}
 }
 
+// ParseBlockWithDecoder parses the block like ParseBlock, and also decodes the txs with decoder into
+// DecodedTxs. The txs which can not be decoded are left nil, so that DecodedTxs stays aligned with
+// the DeliverTx results of the block
+func ParseBlockWithDecoder(cdc *codec.LegacyAmino, decoder TxDecoder, block *tmtypes.Block) Block {
+	b := ParseBlock(cdc, block)
+	b.DecodedTxs = make([]Tx, len(block.Txs))
+	for i, bz := range block.Txs {
+		if tx, err := decoder(bz); err == nil {
+			b.DecodedTxs[i] = tx
+		}
+	}
+	return b
+}
+
 type BlockResult struct {
 	Height  int64         `json:"height"`
 	Results ABCIResponses `json:"results"`
@@ -49,6 +75,28 @@ type BlockDetail struct {
 	BlockResult BlockResult     `json:"block_result"`
 }
 
+// BlockHandler handles the blocks delivered by StreamBlocks, the stream stops if it returns an error
+type BlockHandler func(block BlockDetail) error
+
+// StreamBlocksOptions are the options of StreamBlocks
+type StreamBlocksOptions struct {
+	// number of blocks fetched in parallel, 4 by default
+	Concurrency int
+	// interval of polling the latest height in the tail mode, as a fallback of the subscription, 5s by default
+	PollInterval time.Duration
+}
+
+// Normalize fills the default values of the options
+func (opts StreamBlocksOptions) Normalize() StreamBlocksOptions {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultStreamConcurrency
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultStreamPollInterval
+	}
+	return opts
+}
+
 type ABCIResponses struct {
 	DeliverTx  []TxResult
 	EndBlock   ResultEndBlock
@@ -1,15 +1,20 @@
 package types
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/irisnet/irishub-sdk-go/types/store"
 )
 
 type TxManager interface {
 	TmQuery
 	BuildAndSend(msg []Msg, baseTx BaseTx) (ResultTx, Error)
+	BuildAndSendWithContext(ctx context.Context, msg []Msg, baseTx BaseTx) (ResultTx, Error)
 	SendBatch(msgs Msgs, baseTx BaseTx) ([]ResultTx, Error)
 	BuildAndSendWithAccount(addr string, accountNumber, sequence uint64, msg []Msg, baseTx BaseTx) (ResultTx, Error)
 }
@@ -42,7 +47,10 @@ type AccountQuery interface {
 type TmQuery interface {
 	QueryTx(hash string) (ResultQueryTx, error)
 	QueryTxs(builder *EventQueryBuilder, page, size int) (ResultSearchTxs, error)
+	SearchTxs(builder *EventQueryBuilder, page, size int, order Order) (ResultSearchTxs, error)
+	IterateTxs(builder *EventQueryBuilder, size int, order Order) *TxIterator
 	QueryBlock(height int64) (BlockDetail, error)
+	StreamBlocks(ctx context.Context, from, to int64, opts StreamBlocksOptions, handler BlockHandler) error
 }
 
 type TokenManager interface {
@@ -55,6 +63,12 @@ type TokenConvert interface {
 	ToMainCoin(coin ...Coin) (DecCoins, Error)
 }
 
+type OutboxManager interface {
+	QueryOutbox(status ...store.OutboxStatus) ([]store.OutboxEntry, Error)
+	QueryOutboxEntry(hash string) (store.OutboxEntry, Error)
+	ReconcileOutbox() ([]store.OutboxEntry, Error)
+}
+
 type Logger interface {
 	Logger() log.Logger
 	SetLogger(log.Logger)
@@ -62,6 +76,7 @@ type Logger interface {
 
 type BaseClient interface {
 	TxManager
+	OutboxManager
 	TokenManager
 	KeyManager
 	Queries
@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"os"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	"github.com/irisnet/irishub-sdk-go/types/store"
 )
 
@@ -58,6 +62,21 @@ type ClientConfig struct {
 
 	//whether to enable caching
 	Cached bool
+
+	//metrics collector of the sdk, no metrics will be collected by default
+	Metrics Metrics
+
+	//tracer provider of the sdk, the global provider of opentelemetry is used by default
+	TracerProvider trace.TracerProvider
+
+	//retry policy of the broadcasts and queries
+	RetryPolicy RetryPolicy
+
+	//persistent record of the signed transactions, disabled if nil, see BaseClient.ReconcileOutbox
+	Outbox store.OutboxDAO
+
+	//whether to decode the msgs of unregistered types with the descriptors fetched from the grpc reflection service of the node
+	DynamicDecode bool
 }
 
 func NewClientConfig(uri, grpcAddr, chainID string, options ...Option) (ClientConfig, error) {
@@ -119,6 +138,18 @@ func (cfg *ClientConfig) checkAndSetDefault() error {
 		return err
 	}
 
+	if err := MetricsOption(cfg.Metrics)(cfg); err != nil {
+		return err
+	}
+
+	if err := TracerProviderOption(cfg.TracerProvider)(cfg); err != nil {
+		return err
+	}
+
+	if err := RetryPolicyOption(cfg.RetryPolicy)(cfg); err != nil {
+		return err
+	}
+
 	return GasAdjustmentOption(cfg.GasAdjustment)(cfg)
 }
 
@@ -165,6 +196,9 @@ func AlgoOption(algo string) Option {
 		if algo == "" {
 			algo = defaultAlgo
 		}
+		if _, err := hd.NewSigningAlgoFromString(algo); err != nil {
+			return err
+		}
 		cfg.Algo = algo
 		return nil
 	}
@@ -226,3 +260,59 @@ func CachedOption(enabled bool) Option {
 		return nil
 	}
 }
+
+func MetricsOption(metrics Metrics) Option {
+	return func(cfg *ClientConfig) error {
+		if metrics == nil {
+			metrics = NopMetrics()
+		}
+		cfg.Metrics = metrics
+		return nil
+	}
+}
+
+func TracerProviderOption(provider trace.TracerProvider) Option {
+	return func(cfg *ClientConfig) error {
+		if provider == nil {
+			provider = otel.GetTracerProvider()
+		}
+		cfg.TracerProvider = provider
+		return nil
+	}
+}
+
+func RetryPolicyOption(policy RetryPolicy) Option {
+	return func(cfg *ClientConfig) error {
+		if policy.MaxAttempts <= 0 {
+			policy = DefaultRetryPolicy()
+		}
+		if policy.InitialBackoff < 0 || policy.MaxBackoff < 0 || policy.Multiplier < 0 {
+			return fmt.Errorf("backoff of the retry policy can not be negative")
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			return fmt.Errorf("jitter of the retry policy must be in [0, 1], got %v", policy.Jitter)
+		}
+		if policy.Multiplier == 0 {
+			policy.Multiplier = 1
+		}
+		if policy.Retryable == nil {
+			policy.Retryable = IsRetryable
+		}
+		cfg.RetryPolicy = policy
+		return nil
+	}
+}
+
+func OutboxOption(outbox store.OutboxDAO) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.Outbox = outbox
+		return nil
+	}
+}
+
+func DynamicDecodeOption(enabled bool) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.DynamicDecode = enabled
+		return nil
+	}
+}
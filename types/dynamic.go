@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var (
+	_ Msg                 = &DynamicMsg{}
+	_ TxWithMemo          = &DynamicTx{}
+	_ TxWithTimeoutHeight = &DynamicTx{}
+)
+
+var errDynamicMsg = errors.New("dynamic msg is decoded for display only")
+
+// DynamicMsg is a msg whose type is not registered to the client. It is decoded with the descriptors
+// fetched from the grpc reflection service of the node, see DynamicDecodeOption, and can only be displayed.
+type DynamicMsg struct {
+	// type url of the msg, e.g. /irismod.nft.MsgMintNFT
+	TypeURL string
+	// proto3 json of the msg, including the "@type" field
+	JSON json.RawMessage
+}
+
+func (m *DynamicMsg) Reset()         { *m = DynamicMsg{} }
+func (m *DynamicMsg) String() string { return string(m.JSON) }
+func (m *DynamicMsg) ProtoMessage()  {}
+
+// Route returns the package of the msg type
+func (m *DynamicMsg) Route() string {
+	name := strings.TrimPrefix(m.TypeURL, "/")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+// Type returns the name of the msg type
+func (m *DynamicMsg) Type() string {
+	return m.TypeURL[strings.LastIndex(m.TypeURL, ".")+1:]
+}
+
+func (m *DynamicMsg) ValidateBasic() error {
+	return errDynamicMsg
+}
+
+func (m *DynamicMsg) GetSignBytes() []byte {
+	return MustSortJSON(m.JSON)
+}
+
+func (m *DynamicMsg) GetSigners() []AccAddress {
+	return nil
+}
+
+func (m *DynamicMsg) MarshalJSON() ([]byte, error) {
+	return m.JSON, nil
+}
+
+// DynamicTx is a transaction containing at least one DynamicMsg, the other msgs are decoded as usual
+type DynamicTx struct {
+	Msgs          []Msg
+	Memo          string
+	TimeoutHeight uint64
+	// proto3 json of the whole transaction
+	JSON json.RawMessage
+}
+
+func (tx *DynamicTx) GetMsgs() []Msg {
+	return tx.Msgs
+}
+
+func (tx *DynamicTx) ValidateBasic() error {
+	return errDynamicMsg
+}
+
+func (tx *DynamicTx) GetMemo() string {
+	return tx.Memo
+}
+
+func (tx *DynamicTx) GetTimeoutHeight() uint64 {
+	return tx.TimeoutHeight
+}
+
+func (tx *DynamicTx) MarshalJSON() ([]byte, error) {
+	return tx.JSON, nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicMsg(t *testing.T) {
+	msg := &DynamicMsg{
+		TypeURL: "/irismod.nft.MsgMintNFT",
+		JSON:    json.RawMessage(`{"@type":"/irismod.nft.MsgMintNFT","id":"nft1","denom_id":"denom1"}`),
+	}
+
+	require.Equal(t, "irismod.nft", msg.Route())
+	require.Equal(t, "MsgMintNFT", msg.Type())
+	require.Error(t, msg.ValidateBasic())
+	require.Empty(t, msg.GetSigners())
+
+	tx := &DynamicTx{
+		Msgs: []Msg{msg},
+		Memo: "test",
+		JSON: json.RawMessage(`{"body":{"messages":[` + string(msg.JSON) + `],"memo":"test"}}`),
+	}
+	bz, err := json.Marshal(ResultQueryTx{Hash: "hash", Tx: tx})
+	require.NoError(t, err)
+
+	var res struct {
+		Tx struct {
+			Body struct {
+				Messages []map[string]string `json:"messages"`
+				Memo     string              `json:"memo"`
+			} `json:"body"`
+		} `json:"tx"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, "test", res.Tx.Body.Memo)
+	require.Equal(t, "/irismod.nft.MsgMintNFT", res.Tx.Body.Messages[0]["@type"])
+	require.Equal(t, "nft1", res.Tx.Body.Messages[0]["id"])
+}
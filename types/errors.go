@@ -37,32 +37,31 @@ const (
 var (
 	// errUnknown = register(RootCodespace, 111222, "unknown error")
 	errInvalid = register(RootCodespace, 999999, "sdk check error")
-)
 
-func init() {
-	_ = register(RootCodespace, OK, "success")
-	_ = register(RootCodespace, Internal, "internal")
-	_ = register(RootCodespace, TxDecode, "tx parse error")
-	_ = register(RootCodespace, InvalidSequence, "invalid sequence")
-	_ = register(RootCodespace, Unauthorized, "unauthorized")
-	_ = register(RootCodespace, InsufficientFunds, "insufficient funds")
-	_ = register(RootCodespace, UnknownRequest, "unknown request")
-	_ = register(RootCodespace, InvalidAddress, "invalid address")
-	_ = register(RootCodespace, InvalidPubkey, "invalid pubkey")
-	_ = register(RootCodespace, UnknownAddress, "unknown address")
-	_ = register(RootCodespace, InvalidCoins, "invalid coins")
-	_ = register(RootCodespace, OutOfGas, "out of gas")
-	_ = register(RootCodespace, MemoTooLarge, "memo too large")
-	_ = register(RootCodespace, InsufficientFee, "insufficient fee")
-	_ = register(RootCodespace, TooManySignatures, "maximum number of signatures exceeded")
-	_ = register(RootCodespace, NoSignatures, "no signatures supplied")
-	_ = register(RootCodespace, ErrJsonMarshal, "failed to marshal JSON bytes")
-	_ = register(RootCodespace, ErrJsonUnmarshal, "failed to unmarshal JSON bytes")
-	_ = register(RootCodespace, InvalidRequest, "invalid request")
-	_ = register(RootCodespace, TxInMempoolCache, "tx already in mempool")
-	_ = register(RootCodespace, MempoolIsFull, "mempool is full")
-	_ = register(RootCodespace, TxTooLarge, "tx too large")
-}
+	// Sentinel errors of the root codespace, use errors.Is to match them.
+	ErrOK                = register(RootCodespace, OK, "success")
+	ErrInternal          = register(RootCodespace, Internal, "internal")
+	ErrTxDecode          = register(RootCodespace, TxDecode, "tx parse error")
+	ErrInvalidSequence   = register(RootCodespace, InvalidSequence, "invalid sequence")
+	ErrUnauthorized      = register(RootCodespace, Unauthorized, "unauthorized")
+	ErrInsufficientFunds = register(RootCodespace, InsufficientFunds, "insufficient funds")
+	ErrUnknownRequest    = register(RootCodespace, UnknownRequest, "unknown request")
+	ErrInvalidAddress    = register(RootCodespace, InvalidAddress, "invalid address")
+	ErrInvalidPubkey     = register(RootCodespace, InvalidPubkey, "invalid pubkey")
+	ErrUnknownAddress    = register(RootCodespace, UnknownAddress, "unknown address")
+	ErrInvalidCoins      = register(RootCodespace, InvalidCoins, "invalid coins")
+	ErrOutOfGas          = register(RootCodespace, OutOfGas, "out of gas")
+	ErrMemoTooLarge      = register(RootCodespace, MemoTooLarge, "memo too large")
+	ErrInsufficientFee   = register(RootCodespace, InsufficientFee, "insufficient fee")
+	ErrTooManySignatures = register(RootCodespace, TooManySignatures, "maximum number of signatures exceeded")
+	ErrNoSignatures      = register(RootCodespace, NoSignatures, "no signatures supplied")
+	ErrJSONMarshal       = register(RootCodespace, ErrJsonMarshal, "failed to marshal JSON bytes")
+	ErrJSONUnmarshal     = register(RootCodespace, ErrJsonUnmarshal, "failed to unmarshal JSON bytes")
+	ErrInvalidRequest    = register(RootCodespace, InvalidRequest, "invalid request")
+	ErrTxInMempoolCache  = register(RootCodespace, TxInMempoolCache, "tx already in mempool")
+	ErrMempoolIsFull     = register(RootCodespace, MempoolIsFull, "mempool is full")
+	ErrTxTooLarge        = register(RootCodespace, TxTooLarge, "tx too large")
+)
 
 type Code uint32
 type CodeV017 uint32
@@ -76,22 +75,57 @@ type CodeV017 uint32
 // All popular root errors are declared in this package. If an extension has to
 // declare a custom root error, always use register function to ensure
 // error code uniqueness.
+//
+// Errors returned by the node keep the codespace and code of the module which
+// produced them, they can be matched with errors.Is against the sentinel errors
+// declared by each module package.
 type Error interface {
 	Error() string
 	Code() uint32
 	Codespace() string
+	// TxHash returns the hash of the transaction that failed, empty if the error is not produced by a transaction
+	TxHash() string
+	// RawLog returns the log returned by the node, empty if the error is not produced by the node
+	RawLog() string
 }
 
 // GetError is used to covert irishub error to sdk error
 func GetError(codespace string, code uint32, log ...string) Error {
+	return GetTxError("", codespace, code, log...)
+}
+
+// GetTxError is used to covert the irishub error of the transaction identified by txHash to sdk error.
+//
+// The code of the errors registered by module packages is kept as is, the other ones
+// are mapped to the root codes.
+func GetTxError(txHash, codespace string, code uint32, log ...string) Error {
+	var rawLog string
+	if len(log) > 0 {
+		rawLog = log[0]
+	}
+
+	if codespace != RootCodespace {
+		if _, ok := usedCodes[errorID(codespace, code)]; ok {
+			return &sdkError{
+				codespace: codespace,
+				code:      code,
+				desc:      rawLog,
+				txHash:    txHash,
+				rawLog:    rawLog,
+			}
+		}
+	}
+
 	codeV1, ok := v17CodeMap[code]
 	if !ok {
 		codeV1 = InvalidRequest
 	}
-	return sdkError{
+	return &sdkError{
 		codespace: codespace,
 		code:      uint32(codeV1),
-		desc:      log[0],
+		desc:      rawLog,
+		txHash:    txHash,
+		rawLog:    rawLog,
 	}
 }
 
@@ -100,6 +134,9 @@ func GetError(codespace string, code uint32, log ...string) Error {
 // If the wrapped error does not provide ABCICode method (ie. stdlib errors),
 // it will be labeled as internal error.
 //
+// If err is already an Error, it is returned as is so that its codespace and
+// code are kept.
+//
 // If err is nil, this returns nil, avoiding the need for an if statement when
 // wrapping a error returned at the end of a function
 func Wrap(err error) Error {
@@ -107,10 +144,15 @@ func Wrap(err error) Error {
 		return nil
 	}
 
-	return sdkError{
+	if e, ok := err.(Error); ok {
+		return e
+	}
+
+	return &sdkError{
 		codespace: errInvalid.Codespace(),
 		code:      errInvalid.Code(),
 		desc:      err.Error(),
+		cause:     err,
 	}
 }
 
@@ -132,20 +174,46 @@ type sdkError struct {
 	codespace string
 	code      uint32
 	desc      string
+	txHash    string
+	rawLog    string
+	cause     error
 }
 
-func (e sdkError) Error() string {
+func (e *sdkError) Error() string {
 	return e.desc
 }
 
-func (e sdkError) Code() uint32 {
+func (e *sdkError) Code() uint32 {
 	return e.code
 }
 
-func (e sdkError) Codespace() string {
+func (e *sdkError) Codespace() string {
 	return e.codespace
 }
 
+func (e *sdkError) TxHash() string {
+	return e.txHash
+}
+
+func (e *sdkError) RawLog() string {
+	return e.rawLog
+}
+
+// Is reports whether target is an Error with the same codespace and code,
+// it makes the registered errors usable as sentinel values of errors.Is.
+func (e *sdkError) Is(target error) bool {
+	t, ok := target.(Error)
+	if !ok {
+		return false
+	}
+	return e.codespace == t.Codespace() && e.code == t.Code()
+}
+
+// Unwrap returns the error wrapped by Wrap, if any
+func (e *sdkError) Unwrap() error {
+	return e.cause
+}
+
 // register returns an error instance that should be used as the base for
 // creating error instances during runtime.
 //
@@ -155,9 +223,22 @@ func (e sdkError) Codespace() string {
 //
 // Use this function only during a program startup phase.
 func register(codespace string, code Code, description string) Error {
-	err := sdkError{
+	return Register(codespace, uint32(code), description)
+}
+
+// Register returns an error instance of the given codespace, module packages use
+// it to declare the errors that the module of irishub may return, so that
+// GetError can keep their codespace and code.
+//
+// Attempt to reuse a (codespace, code) tuple results in panic.
+func Register(codespace string, code uint32, description string) Error {
+	if e, ok := usedCodes[errorID(codespace, code)]; ok {
+		panic(fmt.Sprintf("error with code %d is already registered in codespace %s: %q", code, codespace, e.Error()))
+	}
+
+	err := &sdkError{
 		codespace: codespace,
-		code:      uint32(code),
+		code:      code,
 		desc:      description,
 	}
 	setUsed(err)
@@ -197,11 +278,13 @@ var v17CodeMap = map[uint32]Code{
 	16: TooManySignatures,
 	17: OutOfGas,
 	18: InvalidRequest,
-	19: InvalidRequest,
-	20: InvalidRequest,
+	19: TxInMempoolCache,
+	20: MempoolIsFull,
 	21: TxTooLarge,
 	22: InvalidRequest,
 	23: InvalidRequest,
+	// incorrect account sequence
+	32: InvalidSequence,
 }
 
 func CatchPanic(fn func(errMsg string)) {
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTxError(t *testing.T) {
+	errExists := Register("test", 2, "already exists")
+	require.Panics(t, func() { Register("test", 2, "duplicate") })
+
+	err := GetTxError("ABCD", "test", 2, "raw log")
+	require.True(t, errors.Is(err, errExists))
+	require.False(t, errors.Is(err, ErrTxDecode))
+	require.Equal(t, "test", err.Codespace())
+	require.Equal(t, uint32(2), err.Code())
+	require.Equal(t, "ABCD", err.TxHash())
+	require.Equal(t, "raw log", err.RawLog())
+
+	// unregistered codes keep mapping to the root codes
+	err = GetError(RootCodespace, 3, "account sequence mismatch")
+	require.True(t, errors.Is(err, ErrInvalidSequence))
+	err = GetError("unknown", 3, "account sequence mismatch")
+	require.False(t, errors.Is(err, ErrInvalidSequence))
+	require.Equal(t, uint32(InvalidSequence), err.Code())
+
+	// wrapping keeps the codespace and code of an Error
+	wrapped := fmt.Errorf("broadcast failed: %w", Wrap(GetError("test", 2, "raw log")))
+	require.True(t, errors.Is(wrapped, errExists))
+	require.Equal(t, uint32(2), Wrap(GetError("test", 2, "raw log")).Code())
+
+	cause := errors.New("connection refused")
+	require.True(t, errors.Is(Wrap(cause), cause))
+	require.True(t, errors.Is(WrapWithMessage(cause, "query account"), cause))
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -136,9 +137,10 @@ type EventValidatorSetUpdatesHandler func(EventDataValidatorSetUpdates)
 
 //EventQueryBuilder for build query string
 type condition struct {
-	key   EventKey
-	value EventValue
-	op    string
+	key     EventKey
+	value   EventValue
+	op      string
+	numeric bool
 }
 
 // Cond return a condition object with a key
@@ -175,9 +177,21 @@ func (c *condition) EQ(v EventValue) *condition {
 	return c.fill(v, "=")
 }
 
-//func (c *condition) Contains(v EventValue) *condition {
-//	return c.fill(v, "CONTAINS")
-//}
+// Contains matches the values containing v
+func (c *condition) Contains(v EventValue) *condition {
+	return c.fill(v, "CONTAINS")
+}
+
+// Exists matches the events having the key, whatever its value
+func (c *condition) Exists() *condition {
+	return c.fill("", "EXISTS")
+}
+
+// Int marks the value as a number, which is not quoted, e.g. for the comparisons of tx.height
+func (c *condition) Int() *condition {
+	c.numeric = true
+	return c
+}
 
 func (c *condition) fill(v EventValue, op string) *condition {
 	c.value = v
@@ -186,9 +200,18 @@ func (c *condition) fill(v EventValue, op string) *condition {
 }
 
 func (c *condition) String() string {
-	if len(c.key) == 0 || len(c.value) == 0 || len(c.op) == 0 {
+	if len(c.key) == 0 || len(c.op) == 0 {
 		return ""
 	}
+	if c.op == "EXISTS" {
+		return fmt.Sprintf("%s %s", c.key, c.op)
+	}
+	if len(c.value) == 0 {
+		return ""
+	}
+	if c.numeric {
+		return fmt.Sprintf("%s %s %s", c.key, c.op, c.value)
+	}
 	return fmt.Sprintf("%s %s '%s'", c.key, c.op, c.value)
 }
 
@@ -212,6 +235,27 @@ func (eqb *EventQueryBuilder) AddCondition(c *condition) *EventQueryBuilder {
 	return eqb
 }
 
+// FromHeight limits the query to the txs at or above height
+func (eqb *EventQueryBuilder) FromHeight(height int64) *EventQueryBuilder {
+	return eqb.AddCondition(Cond(TxHeightKey).GTE(EventValue(strconv.FormatInt(height, 10))).Int())
+}
+
+// ToHeight limits the query to the txs at or below height
+func (eqb *EventQueryBuilder) ToHeight(height int64) *EventQueryBuilder {
+	return eqb.AddCondition(Cond(TxHeightKey).LTE(EventValue(strconv.FormatInt(height, 10))).Int())
+}
+
+// HeightRange limits the query to the txs in [from, to], a bound is ignored if not positive
+func (eqb *EventQueryBuilder) HeightRange(from, to int64) *EventQueryBuilder {
+	if from > 0 {
+		eqb.FromHeight(from)
+	}
+	if to > 0 {
+		eqb.ToHeight(to)
+	}
+	return eqb
+}
+
 //Build is responsible for constructing the listening condition into a listening instruction identified by tendermint
 func (eqb *EventQueryBuilder) Build() string {
 	var buf bytes.Buffer
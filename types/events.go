@@ -124,7 +124,8 @@ func toBytes(i interface{}) []byte {
 
 // Common event types and attribute keys
 var (
-	TypeKey EventKey = "tm.event"
+	TypeKey     EventKey = "tm.event"
+	TxHeightKey EventKey = "tx.height"
 
 	EventTypeMessage         = "message"
 	EventTypeCreateContext   = "create_context"
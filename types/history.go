@@ -0,0 +1,93 @@
+package types
+
+const defaultHistorySize = 20
+
+// HistoryModule is implemented by the modules whose msgs are listed by the account history
+type HistoryModule interface {
+	Module
+
+	// HistoryQueries returns the queries matching the txs of the module which touched the address
+	// without being sent by it, e.g. the txs transferring a token to the address
+	HistoryQueries(address string) []*EventQueryBuilder
+
+	// ParseActivity returns the activity of the msg, false if the msg does not belong to the module
+	ParseActivity(msg Msg) (Activity, bool)
+}
+
+// Activity is what a msg of a tx in the account history did, e.g. a send or a delegation
+type Activity interface {
+	Module() string
+	Action() string
+}
+
+// MsgActivity is the activity of a msg which is not parsed by any module
+type MsgActivity struct {
+	Msg Msg `json:"msg"`
+}
+
+func (a MsgActivity) Module() string {
+	return a.Msg.Route()
+}
+
+func (a MsgActivity) Action() string {
+	return a.Msg.Type()
+}
+
+// HistoryOptions are the options of an account history query
+type HistoryOptions struct {
+	// number of entries per page, 20 by default
+	Size int
+	// order by height, the latest txs come first by default
+	Order Order
+	// the page starts after this position, the first page is returned if nil, see AccountHistory.Next
+	After *HistoryCursor
+	// lower and upper bounds of the tx height, ignored if not positive
+	FromHeight int64
+	ToHeight   int64
+}
+
+// HistoryCursor is the position of a tx in the account history
+type HistoryCursor struct {
+	Height int64  `json:"height"`
+	Index  uint32 `json:"index"`
+}
+
+// Passed reports whether the tx at height and index is at or before the cursor in the given order,
+// such txs belong to the pages up to the cursor
+func (c HistoryCursor) Passed(height int64, index uint32, order Order) bool {
+	if order == OrderAsc {
+		return height < c.Height || (height == c.Height && index <= c.Index)
+	}
+	return height > c.Height || (height == c.Height && index >= c.Index)
+}
+
+// Normalize fills the default values of the options
+func (opts HistoryOptions) Normalize() HistoryOptions {
+	if opts.Size <= 0 {
+		opts.Size = defaultHistorySize
+	}
+	if len(opts.Order) == 0 {
+		opts.Order = OrderDesc
+	}
+	return opts
+}
+
+// HistoryEntry is a tx which touched the account
+type HistoryEntry struct {
+	Hash       string     `json:"hash"`
+	Height     int64      `json:"height"`
+	Index      uint32     `json:"index"`
+	Timestamp  string     `json:"timestamp"`
+	Code       uint32     `json:"code"`
+	Activities []Activity `json:"activities"`
+}
+
+// AccountHistory is a page of the txs which touched the account
+type AccountHistory struct {
+	Address string         `json:"address"`
+	Size    int            `json:"size"`
+	Entries []HistoryEntry `json:"entries"`
+	// position of the last entry, to be passed as HistoryOptions.After to get the next page,
+	// nil if this is the last page
+	Next *HistoryCursor `json:"next,omitempty"`
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+)
+
+// Metrics collects the client side telemetry of the sdk, the implementation
+// must be safe for concurrent use.
+type Metrics interface {
+	// TxBuilt counts an unsigned transaction assembled by the tx factory
+	TxBuilt()
+	// TxSigned counts a transaction signed by the key manager
+	TxSigned()
+	// TxBroadcast counts a transaction broadcast to the node with the given mode and the result code (0 is success)
+	TxBroadcast(mode BroadcastMode, code uint32)
+	// GRPCQuery observes the duration of a grpc query, code is the grpc status code
+	GRPCQuery(method, code string, duration time.Duration)
+	// CacheAccess counts a lookup of the named cache
+	CacheAccess(cache string, hit bool)
+	// SequenceRetry counts a retry caused by an account sequence mismatch
+	SequenceRetry()
+	// EventLag observes the delay between the block time and the delivery of a subscribed event
+	EventLag(event string, lag time.Duration)
+}
+
+// NopMetrics returns a Metrics which discards everything, it is the default of ClientConfig.
+func NopMetrics() Metrics {
+	return nopMetrics{}
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) TxBuilt()                                {}
+func (nopMetrics) TxSigned()                               {}
+func (nopMetrics) TxBroadcast(BroadcastMode, uint32)       {}
+func (nopMetrics) GRPCQuery(string, string, time.Duration) {}
+func (nopMetrics) CacheAccess(string, bool)                {}
+func (nopMetrics) SequenceRetry()                          {}
+func (nopMetrics) EventLag(string, time.Duration)          {}
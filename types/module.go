@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/irisnet/irishub-sdk-go/codec"
 	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
 )
 
@@ -22,12 +23,24 @@ type Module interface {
 	RegisterInterfaceTypes(registry cdctypes.InterfaceRegistry)
 }
 
+// AminoModule is implemented by the modules whose types must also be registered to the
+// legacy amino codec of the client and to the global legacy.Cdc, e.g. the Msgs signed in
+// the amino json sign mode
+type AminoModule interface {
+	Module
+	RegisterLegacyAminoCodec(cdc *codec.LegacyAmino)
+}
+
 type KeyManager interface {
 	Sign(name, password string, data []byte) ([]byte, crypto.PubKey, error)
 	Insert(name, password string) (string, string, error)
 	Recover(name, password, mnemonic string) (string, error)
+	RecoverWithHDPath(name, password, mnemonic, bip39Passphrase, hdPath string) (string, error)
+	Derive(mnemonic, bip39Passphrase string, hdPaths []string) ([]crypto.PubKey, error)
 	Import(name, password string, privKeyArmor string) (address string, err error)
 	Export(name, password string) (privKeyArmor string, err error)
 	Delete(name, password string) error
 	Find(name, password string) (crypto.PubKey, AccAddress, error)
+	// HDPath returns the BIP44 path the key was derived from, empty for an imported key
+	HDPath(name, password string) (string, error)
 }
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 500 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+	defaultMultiplier     = 2.0
+	defaultJitter         = 0.2
+)
+
+// RetryPolicy defines how the client retries the broadcasts and queries failed with a transient error.
+//
+// The attempts of a transaction are counted together, whether it is re-broadcast or signed again.
+// A transaction is only signed again when the node rejected it because of an account sequence
+// mismatch and no earlier attempt may have reached the node, the other retryable errors re-broadcast
+// the same signed bytes, so that a transaction that may already be in the mempool is never signed twice.
+type RetryPolicy struct {
+	// maximum number of attempts including the first one, 1 disables retrying
+	MaxAttempts int
+
+	// backoff before the first retry
+	InitialBackoff time.Duration
+
+	// upper bound of the backoff
+	MaxBackoff time.Duration
+
+	// factor applied to the backoff after each retry
+	Multiplier float64
+
+	// randomization factor of the backoff in [0, 1], the backoff ranges in [b*(1-Jitter), b*(1+Jitter)]
+	Jitter float64
+
+	// Retryable classifies the retryable errors, IsRetryable is used if nil
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		Multiplier:     defaultMultiplier,
+		Jitter:         defaultJitter,
+		Retryable:      IsRetryable,
+	}
+}
+
+// IsRetryable reports whether err is a transient error: a full mempool, an account
+// sequence mismatch, or an unavailable or timed out node, either through grpc or through
+// a network error of the tendermint rpc.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, ErrMempoolIsFull) ||
+		errors.Is(err, ErrInvalidSequence) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	// a refused or reset connection, or a failed request of the rpc client, e.g. a *url.Error
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var ne net.Error
+	if errors.As(err, &ne) {
+		return true
+	}
+
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		switch se.GRPCStatus().Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldRetry reports whether the attempt-th (starting from 1) attempt failed with err may be retried
+func (p RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	if err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	return retryable(err)
+}
+
+// Backoff returns the delay before the retry following the attempt-th (starting from 1) attempt
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		delta := p.Jitter * backoff
+		backoff = backoff - delta + rand.Float64()*2*delta
+	}
+	return time.Duration(backoff)
+}
+
+// Wait blocks for the backoff of the attempt-th attempt, it returns early with the error of ctx if ctx is done
+func (p RetryPolicy) Wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.Backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
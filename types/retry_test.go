@@ -0,0 +1,87 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsRetryable(t *testing.T) {
+	require.True(t, IsRetryable(GetError(RootCodespace, uint32(MempoolIsFull), "mempool is full")))
+	require.True(t, IsRetryable(GetError(RootCodespace, uint32(InvalidSequence), "account sequence mismatch")))
+	require.True(t, IsRetryable(Wrap(status.Error(codes.Unavailable, "connection refused"))))
+	require.True(t, IsRetryable(fmt.Errorf("query: %w", status.Error(codes.DeadlineExceeded, "timeout"))))
+	require.True(t, IsRetryable(Wrap(context.DeadlineExceeded)))
+
+	// the network errors of the tendermint rpc
+	refused := &url.Error{Op: "Post", URL: "http://localhost:26657", Err: &net.OpError{
+		Op:  "dial",
+		Net: "tcp",
+		Err: os.NewSyscallError("connect", syscall.ECONNREFUSED),
+	}}
+	require.True(t, IsRetryable(Wrap(fmt.Errorf("post failed: %w", refused))))
+	require.True(t, IsRetryable(fmt.Errorf("read: %w", syscall.ECONNRESET)))
+	require.True(t, IsRetryable(&net.DNSError{Err: "timeout", Name: "node", IsTimeout: true}))
+
+	// a node which is not listening
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, lis.Close())
+	_, err = http.Post("http://"+lis.Addr().String(), "application/json", nil)
+	require.Error(t, err)
+	require.True(t, IsRetryable(Wrap(err)))
+
+	require.False(t, IsRetryable(nil))
+	require.False(t, IsRetryable(&url.Error{Op: "Post", URL: "http://localhost:26657", Err: context.Canceled}))
+	require.False(t, IsRetryable(GetError(RootCodespace, uint32(InsufficientFunds), "insufficient funds")))
+	require.False(t, IsRetryable(status.Error(codes.NotFound, "account not found")))
+	require.False(t, IsRetryable(errors.New("unknown")))
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+	require.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	require.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	require.Equal(t, 300*time.Millisecond, policy.Backoff(3))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		backoff := policy.Backoff(2)
+		require.True(t, backoff >= 100*time.Millisecond && backoff <= 300*time.Millisecond, backoff.String())
+	}
+
+	errFull := GetError(RootCodespace, uint32(MempoolIsFull), "mempool is full")
+	require.True(t, policy.ShouldRetry(1, errFull))
+	require.True(t, policy.ShouldRetry(2, errFull))
+	require.False(t, policy.ShouldRetry(3, errFull))
+	require.False(t, policy.ShouldRetry(1, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Equal(t, context.Canceled, policy.Wait(ctx, 1))
+}
+
+func TestRetryPolicyOption(t *testing.T) {
+	cfg := ClientConfig{}
+	require.NoError(t, RetryPolicyOption(RetryPolicy{})(&cfg))
+	require.Equal(t, defaultMaxAttempts, cfg.RetryPolicy.MaxAttempts)
+	require.NotNil(t, cfg.RetryPolicy.Retryable)
+
+	require.Error(t, RetryPolicyOption(RetryPolicy{MaxAttempts: 1, Jitter: 2})(&cfg))
+}
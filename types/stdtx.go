@@ -222,6 +222,7 @@ type ResultTx struct {
 type ResultQueryTx struct {
 	Hash      string   `json:"hash"`
 	Height    int64    `json:"height"`
+	Index     uint32   `json:"index"`
 	Tx        Tx       `json:"tx"`
 	Result    TxResult `json:"result"`
 	Timestamp string   `json:"timestamp"`
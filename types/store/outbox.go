@@ -0,0 +1,200 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	outboxDBName = "outbox"
+	outboxPrefix = "outbox/"
+)
+
+// OutboxStatus is the status of a signed transaction recorded in the outbox
+type OutboxStatus string
+
+const (
+	// OutboxPending means the transaction is signed, but whether it reached the node is unknown
+	OutboxPending OutboxStatus = "pending"
+	// OutboxBroadcast means the transaction is accepted by the node, but not committed yet
+	OutboxBroadcast OutboxStatus = "broadcast"
+	// OutboxCommitted means the transaction is committed successfully
+	OutboxCommitted OutboxStatus = "committed"
+	// OutboxFailed means the transaction is rejected by the node or failed in the block
+	OutboxFailed OutboxStatus = "failed"
+)
+
+// OutboxEntry is the record of a signed transaction
+type OutboxEntry struct {
+	Hash      string       `json:"hash"`
+	Address   string       `json:"address"`
+	Sequence  uint64       `json:"sequence"`
+	TxBytes   []byte       `json:"tx_bytes"`
+	Status    OutboxStatus `json:"status"`
+	Height    int64        `json:"height,omitempty"`
+	Log       string       `json:"log,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// OutboxDAO persists the signed transactions, so that they can be reconciled after a restart
+type OutboxDAO interface {
+	// Save creates or overwrites the entry identified by its hash
+	Save(entry OutboxEntry) error
+
+	// Get returns the entry of the given hash
+	Get(hash string) (OutboxEntry, error)
+
+	// List returns the entries in the given status ordered by creation time, all entries if none is given
+	List(status ...OutboxStatus) ([]OutboxEntry, error)
+
+	// Delete removes the entry of the given hash
+	Delete(hash string) error
+}
+
+var (
+	_ OutboxDAO = LevelDBOutbox{}
+	_ OutboxDAO = &MemoryOutbox{}
+)
+
+// LevelDBOutbox is an OutboxDAO stored in leveldb, entries are written synchronously
+type LevelDBOutbox struct {
+	db dbm.DB
+}
+
+// NewLevelDBOutbox opens the outbox stored under rootDir
+func NewLevelDBOutbox(rootDir string) (OutboxDAO, error) {
+	db, err := dbm.NewGoLevelDB(outboxDBName, filepath.Join(rootDir, outboxDBName))
+	if err != nil {
+		return nil, err
+	}
+	return LevelDBOutbox{db: db}, nil
+}
+
+func (o LevelDBOutbox) Save(entry OutboxEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return o.db.SetSync(outboxKey(entry.Hash), bz)
+}
+
+func (o LevelDBOutbox) Get(hash string) (entry OutboxEntry, err error) {
+	bz, err := o.db.Get(outboxKey(hash))
+	if err != nil {
+		return entry, err
+	}
+	if bz == nil {
+		return entry, fmt.Errorf("outbox entry %s not found", hash)
+	}
+
+	err = json.Unmarshal(bz, &entry)
+	return
+}
+
+func (o LevelDBOutbox) List(status ...OutboxStatus) ([]OutboxEntry, error) {
+	it, err := dbm.IteratePrefix(o.db, []byte(outboxPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var entries []OutboxEntry
+	for ; it.Valid(); it.Next() {
+		var entry OutboxEntry
+		if err := json.Unmarshal(it.Value(), &entry); err != nil {
+			return nil, err
+		}
+		if matchStatus(entry, status) {
+			entries = append(entries, entry)
+		}
+	}
+	sortEntries(entries)
+	return entries, nil
+}
+
+func (o LevelDBOutbox) Delete(hash string) error {
+	return o.db.DeleteSync(outboxKey(hash))
+}
+
+// MemoryOutbox is an OutboxDAO kept in memory, it does not survive a restart and is meant for testing
+type MemoryOutbox struct {
+	mu      sync.RWMutex
+	entries map[string]OutboxEntry
+}
+
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{entries: make(map[string]OutboxEntry)}
+}
+
+func (o *MemoryOutbox) Save(entry OutboxEntry) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.entries[entry.Hash] = entry
+	return nil
+}
+
+func (o *MemoryOutbox) Get(hash string) (OutboxEntry, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	entry, ok := o.entries[hash]
+	if !ok {
+		return entry, fmt.Errorf("outbox entry %s not found", hash)
+	}
+	return entry, nil
+}
+
+func (o *MemoryOutbox) List(status ...OutboxStatus) ([]OutboxEntry, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var entries []OutboxEntry
+	for _, entry := range o.entries {
+		if matchStatus(entry, status) {
+			entries = append(entries, entry)
+		}
+	}
+	sortEntries(entries)
+	return entries, nil
+}
+
+func (o *MemoryOutbox) Delete(hash string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.entries, hash)
+	return nil
+}
+
+func outboxKey(hash string) []byte {
+	return []byte(outboxPrefix + hash)
+}
+
+func matchStatus(entry OutboxEntry, status []OutboxStatus) bool {
+	if len(status) == 0 {
+		return true
+	}
+	for _, s := range status {
+		if entry.Status == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortEntries(entries []OutboxEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].Sequence < entries[j].Sequence
+		}
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelDBOutbox(t *testing.T) {
+	outbox, err := NewLevelDBOutbox(t.TempDir())
+	require.NoError(t, err)
+
+	now := time.Now()
+	entries := []OutboxEntry{
+		{Hash: "A", Sequence: 1, Status: OutboxCommitted, CreatedAt: now},
+		{Hash: "B", Sequence: 2, Status: OutboxPending, CreatedAt: now.Add(time.Second)},
+		{Hash: "C", Sequence: 3, Status: OutboxBroadcast, CreatedAt: now.Add(2 * time.Second)},
+		{Hash: "D", Sequence: 4, Status: OutboxFailed, CreatedAt: now.Add(3 * time.Second), Log: "out of gas"},
+	}
+	for _, entry := range entries {
+		require.NoError(t, outbox.Save(entry))
+	}
+
+	entry, err := outbox.Get("D")
+	require.NoError(t, err)
+	require.Equal(t, OutboxFailed, entry.Status)
+	require.Equal(t, "out of gas", entry.Log)
+
+	_, err = outbox.Get("E")
+	require.Error(t, err)
+
+	all, err := outbox.List()
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+
+	unresolved, err := outbox.List(OutboxPending, OutboxBroadcast)
+	require.NoError(t, err)
+	require.Len(t, unresolved, 2)
+	require.Equal(t, "B", unresolved[0].Hash)
+	require.Equal(t, "C", unresolved[1].Hash)
+
+	entry.Status = OutboxCommitted
+	require.NoError(t, outbox.Save(entry))
+	committed, err := outbox.List(OutboxCommitted)
+	require.NoError(t, err)
+	require.Len(t, committed, 2)
+
+	require.NoError(t, outbox.Delete("A"))
+	all, err = outbox.List()
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+}
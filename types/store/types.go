@@ -26,6 +26,8 @@ type KeyInfo struct {
 	PubKey       []byte `json:"pubkey"`
 	PrivKeyArmor string `json:"priv_key_armor"`
 	Algo         string `json:"algo"`
+	// BIP44 path the key was derived from, empty for imported keys
+	HDPath string `json:"hd_path,omitempty"`
 }
 
 type KeyDAO interface {
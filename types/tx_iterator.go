@@ -0,0 +1,93 @@
+package types
+
+import "fmt"
+
+// Order is the order of the txs returned by a search, by height then index in the block
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Validate returns an error if the order is neither asc nor desc
+func (o Order) Validate() error {
+	switch o {
+	case OrderAsc, OrderDesc:
+		return nil
+	default:
+		return fmt.Errorf("invalid order %s, expected asc or desc", o)
+	}
+}
+
+// TxIterator iterates the txs matching a search page by page, the next page is only fetched
+// when the txs of the current page are consumed:
+//
+//	it := client.IterateTxs(builder, 50, types.OrderDesc)
+//	for it.Next() {
+//		tx := it.Tx()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type TxIterator struct {
+	search func(page int) (ResultSearchTxs, error)
+
+	page    int
+	total   int
+	fetched int
+	txs     []ResultQueryTx
+	index   int
+	cur     ResultQueryTx
+	done    bool
+	err     error
+}
+
+// NewTxIterator returns a TxIterator fetching the pages, starting from 1, with search
+func NewTxIterator(search func(page int) (ResultSearchTxs, error)) *TxIterator {
+	return &TxIterator{search: search}
+}
+
+// Next moves to the next tx, it returns false when the txs are exhausted or an error occurs
+func (it *TxIterator) Next() bool {
+	for it.index >= len(it.txs) {
+		if it.done || (it.page > 0 && it.fetched >= it.total) {
+			it.done = true
+			return false
+		}
+
+		res, err := it.search(it.page + 1)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+
+		it.page++
+		it.total = res.Total
+		it.fetched += len(res.Txs)
+		it.txs, it.index = res.Txs, 0
+		if len(res.Txs) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.cur = it.txs[it.index]
+	it.index++
+	return true
+}
+
+// Tx returns the current tx
+func (it *TxIterator) Tx() ResultQueryTx {
+	return it.cur
+}
+
+// Total returns the count of the txs matching the search, known once the first page is fetched
+func (it *TxIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *TxIterator) Err() error {
+	return it.err
+}
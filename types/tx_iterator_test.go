@@ -0,0 +1,64 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventQueryBuilder(t *testing.T) {
+	query := NewEventQueryBuilder().
+		AddCondition(NewCond("message", "sender").EQ("iaa1sender")).
+		AddCondition(NewCond("transfer", "recipient").Contains("iaa1")).
+		AddCondition(NewCond("message", "module").Exists()).
+		HeightRange(10, 20).
+		Build()
+	require.Equal(t, "message.sender = 'iaa1sender' AND transfer.recipient CONTAINS 'iaa1' AND "+
+		"message.module EXISTS AND tx.height >= 10 AND tx.height <= 20", query)
+
+	require.Equal(t, "tx.height <= 5", NewEventQueryBuilder().HeightRange(0, 5).Build())
+	require.Empty(t, NewCond("message", "sender").EQ("").String())
+}
+
+func TestTxIterator(t *testing.T) {
+	var txs []ResultQueryTx
+	for i := 0; i < 7; i++ {
+		txs = append(txs, ResultQueryTx{Hash: fmt.Sprint(i)})
+	}
+
+	var pages []int
+	search := func(page int) (ResultSearchTxs, error) {
+		pages = append(pages, page)
+		begin, end := (page-1)*3, page*3
+		if end > len(txs) {
+			end = len(txs)
+		}
+		return ResultSearchTxs{Total: len(txs), Txs: txs[begin:end]}, nil
+	}
+
+	it := NewTxIterator(search)
+	var hashes []string
+	for it.Next() {
+		hashes = append(hashes, it.Tx().Hash)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6"}, hashes)
+	require.Equal(t, []int{1, 2, 3}, pages)
+	require.Equal(t, 7, it.Total())
+	require.False(t, it.Next())
+
+	empty := NewTxIterator(func(page int) (ResultSearchTxs, error) {
+		return ResultSearchTxs{}, nil
+	})
+	require.False(t, empty.Next())
+	require.NoError(t, empty.Err())
+
+	failed := NewTxIterator(func(page int) (ResultSearchTxs, error) {
+		return ResultSearchTxs{}, errors.New("node unavailable")
+	})
+	require.False(t, failed.Next())
+	require.Error(t, failed.Err())
+	require.Error(t, Order("latest").Validate())
+}
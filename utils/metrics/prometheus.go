@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	resultHit  = "hit"
+	resultMiss = "miss"
+)
+
+var _ sdk.Metrics = &PrometheusMetrics{}
+
+// PrometheusMetrics implements sdk.Metrics with prometheus collectors.
+type PrometheusMetrics struct {
+	txsBuilt      prometheus.Counter
+	txsSigned     prometheus.Counter
+	txsBroadcast  *prometheus.CounterVec
+	grpcQueries   *prometheus.HistogramVec
+	cacheRequests *prometheus.CounterVec
+	sequenceRetry prometheus.Counter
+	eventLag      *prometheus.HistogramVec
+}
+
+// NewPrometheusMetrics creates the collectors under the given namespace and registers
+// them to registerer, prometheus.DefaultRegisterer is used if registerer is nil.
+func NewPrometheusMetrics(namespace string, registerer prometheus.Registerer) (*PrometheusMetrics, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	m := &PrometheusMetrics{
+		txsBuilt: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "txs_built_total",
+			Help:      "Number of unsigned transactions built.",
+		}),
+		txsSigned: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "txs_signed_total",
+			Help:      "Number of transactions signed.",
+		}),
+		txsBroadcast: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "txs_broadcast_total",
+			Help:      "Number of transactions broadcast, by broadcast mode and result code.",
+		}, []string{"mode", "code"}),
+		grpcQueries: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_query_duration_seconds",
+			Help:      "Duration of grpc queries, by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_requests_total",
+			Help:      "Number of cache lookups, by cache and result (hit|miss).",
+		}, []string{"cache", "result"}),
+		sequenceRetry: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "sequence_retries_total",
+			Help:      "Number of broadcast retries caused by an account sequence mismatch.",
+		}),
+		eventLag: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "subscription_event_lag_seconds",
+			Help:      "Delay between the block time and the delivery of a subscribed event, by event type.",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"event"}),
+	}
+
+	for _, c := range []prometheus.Collector{
+		m.txsBuilt, m.txsSigned, m.txsBroadcast, m.grpcQueries,
+		m.cacheRequests, m.sequenceRetry, m.eventLag,
+	} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *PrometheusMetrics) TxBuilt() {
+	m.txsBuilt.Inc()
+}
+
+func (m *PrometheusMetrics) TxSigned() {
+	m.txsSigned.Inc()
+}
+
+func (m *PrometheusMetrics) TxBroadcast(mode sdk.BroadcastMode, code uint32) {
+	m.txsBroadcast.WithLabelValues(string(mode), strconv.FormatUint(uint64(code), 10)).Inc()
+}
+
+func (m *PrometheusMetrics) GRPCQuery(method, code string, duration time.Duration) {
+	m.grpcQueries.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+func (m *PrometheusMetrics) CacheAccess(cache string, hit bool) {
+	result := resultMiss
+	if hit {
+		result = resultHit
+	}
+	m.cacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+func (m *PrometheusMetrics) SequenceRetry() {
+	m.sequenceRetry.Inc()
+}
+
+func (m *PrometheusMetrics) EventLag(event string, lag time.Duration) {
+	m.eventLag.WithLabelValues(event).Observe(lag.Seconds())
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m, err := NewPrometheusMetrics("sdk", registry)
+	require.NoError(t, err)
+
+	m.TxBuilt()
+	m.TxSigned()
+	m.TxBroadcast(sdk.Sync, 0)
+	m.TxBroadcast(sdk.Sync, 32)
+	m.TxBroadcast(sdk.Commit, 0)
+	m.CacheAccess("account", true)
+	m.CacheAccess("account", false)
+	m.CacheAccess("account", false)
+	m.SequenceRetry()
+	m.GRPCQuery("/cosmos.auth.v1beta1.Query/Account", "OK", time.Millisecond)
+	m.EventLag("NewBlock", time.Second)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(m.txsBuilt))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.txsSigned))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.txsBroadcast.WithLabelValues("sync", "32")))
+	require.Equal(t, float64(2), testutil.ToFloat64(m.cacheRequests.WithLabelValues("account", "miss")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.sequenceRetry))
+	mfs, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, mfs, 7)
+
+	// registering twice on the same registry must fail
+	_, err = NewPrometheusMetrics("sdk", registry)
+	require.Error(t, err)
+}